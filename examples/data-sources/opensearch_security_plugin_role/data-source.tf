@@ -0,0 +1,7 @@
+data "opensearch_security_plugin_role" "all_access" {
+  role_name = "all_access"
+}
+
+output "all_access_cluster_permissions" {
+  value = data.opensearch_security_plugin_role.all_access.cluster_permissions
+}
@@ -0,0 +1,9 @@
+# all roles whose names start with "logs_", excluding those shipped with the security plugin
+data "opensearch_security_plugin_roles" "logs" {
+  name_regex = "^logs_"
+  reserved   = false
+}
+
+output "log_role_names" {
+  value = data.opensearch_security_plugin_roles.logs.names
+}
@@ -1,3 +1,6 @@
 provider "opensearch" {
-  # example configuration here
+  addresses = ["https://localhost:9200"]
+
+  # credentials are read from the OPENSEARCH_USERNAME and OPENSEARCH_PASSWORD
+  # environment variables when not set here
 }
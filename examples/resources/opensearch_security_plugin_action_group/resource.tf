@@ -0,0 +1,9 @@
+resource "opensearch_security_plugin_action_group" "example" {
+  action_group_name = "example-read-only"
+  type              = "index"
+  description       = "Search and get access"
+  allowed_actions = [
+    "indices:data/read/search*",
+    "indices:data/read/get*",
+  ]
+}
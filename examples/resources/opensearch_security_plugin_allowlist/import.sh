@@ -0,0 +1 @@
+terraform import opensearch_security_plugin_allowlist.example config
@@ -0,0 +1,9 @@
+resource "opensearch_security_plugin_allowlist" "example" {
+  enabled = true
+
+  requests = {
+    "/_cluster/settings"         = ["GET"]
+    "/_cat/nodes"                = ["GET"]
+    "/_plugins/_security/whoami" = ["GET"]
+  }
+}
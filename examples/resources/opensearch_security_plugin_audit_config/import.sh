@@ -0,0 +1 @@
+terraform import opensearch_security_plugin_audit_config.example config
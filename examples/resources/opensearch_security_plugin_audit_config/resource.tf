@@ -0,0 +1,21 @@
+resource "opensearch_security_plugin_audit_config" "example" {
+  enabled = true
+
+  audit = {
+    enable_rest                   = true
+    disabled_rest_categories      = ["AUTHENTICATED", "GRANTED_PRIVILEGES"]
+    enable_transport              = false
+    disabled_transport_categories = []
+    ignore_users                  = ["kibanaserver"]
+    ignore_requests               = []
+  }
+
+  compliance = {
+    enabled         = true
+    external_config = true
+    read_watched_fields = {
+      "customers-*" = ["ssn", "credit_card"]
+    }
+    write_watched_indices = ["customers-*"]
+  }
+}
@@ -0,0 +1,5 @@
+# import the security config, adopting no existing authentication or authorization domains
+terraform import opensearch_security_plugin_config.example config
+
+# import the security config, adopting the named domains.  adopted domains are never removed by this resource.
+terraform import opensearch_security_plugin_config.example config,authc:basic_internal_auth_domain,authz:roles_from_myldap
@@ -0,0 +1,61 @@
+variable "ldap_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "opensearch_security_plugin_config" "example" {
+  http = {
+    xff_enabled          = true
+    xff_internal_proxies = "10\\.0\\.0\\.\\d+"
+    xff_remote_ip_header = "x-forwarded-for"
+  }
+
+  kibana = {
+    multitenancy_enabled   = true
+    private_tenant_enabled = false
+    default_tenant         = "global_tenant"
+  }
+
+  authc {
+    name  = "openid_auth_domain"
+    order = 1
+
+    openid {
+      openid_connect_url = "https://idp.example.com/.well-known/openid-configuration"
+      subject_key        = "preferred_username"
+      roles_key          = "roles"
+    }
+  }
+
+  authc {
+    name  = "ldap_auth_domain"
+    order = 2
+
+    basic {
+      challenge = false
+    }
+
+    ldap {
+      hosts      = ["ldap.example.com:636"]
+      enable_ssl = true
+      bind_dn    = "cn=admin,dc=example,dc=com"
+      password   = var.ldap_password
+      userbase   = "ou=people,dc=example,dc=com"
+      usersearch = "(uid={0})"
+    }
+  }
+
+  authz {
+    name = "roles_from_ldap"
+
+    ldap {
+      hosts      = ["ldap.example.com:636"]
+      enable_ssl = true
+      bind_dn    = "cn=admin,dc=example,dc=com"
+      password   = var.ldap_password
+      rolebase   = "ou=groups,dc=example,dc=com"
+      rolesearch = "(member={0})"
+      rolename   = "cn"
+    }
+  }
+}
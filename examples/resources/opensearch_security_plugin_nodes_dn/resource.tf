@@ -0,0 +1,7 @@
+resource "opensearch_security_plugin_nodes_dn" "example" {
+  cluster_name = "remote-cluster"
+  nodes_dn = [
+    "CN=node1.remote.example.com,OU=Ops,O=Example,C=US",
+    "CN=node2.remote.example.com,OU=Ops,O=Example,C=US",
+  ]
+}
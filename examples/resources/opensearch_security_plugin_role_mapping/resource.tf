@@ -0,0 +1,6 @@
+resource "opensearch_security_plugin_role_mapping" "example" {
+  role_name     = opensearch_security_plugin_role.example.role_name
+  description   = "Maps the example role"
+  backend_roles = ["example-backend-role"]
+  users         = [opensearch_security_plugin_user.example.username]
+}
@@ -0,0 +1,15 @@
+resource "opensearch_security_plugin_tenant" "example" {
+  tenant_name = "example-tenant"
+  description = "Example tenant"
+}
+
+resource "opensearch_security_plugin_role" "example_tenant_user" {
+  role_name = "example-tenant-user"
+
+  tenant_permissions = [
+    {
+      tenant_patterns = [opensearch_security_plugin_tenant.example.tenant_name]
+      allowed_actions = ["kibana_all_read"]
+    },
+  ]
+}
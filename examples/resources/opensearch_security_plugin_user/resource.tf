@@ -0,0 +1,13 @@
+resource "opensearch_security_plugin_user" "example" {
+  username      = "example-user"
+  password      = var.example_user_password
+  backend_roles = ["example-backend-role"]
+
+  opendistro_security_roles = [
+    opensearch_security_plugin_role.example.role_name,
+  ]
+
+  attributes = {
+    team = "example"
+  }
+}
@@ -1,17 +1,39 @@
 package acctest
 
 import (
-	"os"
 	"strings"
 
 	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
 	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
 )
 
+// ConfigLiteral values are written into compiled configuration as-is, which is useful for resource references
+type ConfigLiteral = at.ConfigLiteral
+
 func CombineConfig(in ...string) string {
 	return strings.Join(in, "\n\n")
 }
 
+// Block compiles a nested block, which may have further blocks added to it with AppendBlock or AppendBlocks
+func Block(blockName string, fieldMaps ...map[string]interface{}) string {
+	return at.CompileConfig(blockName, fieldMaps...)
+}
+
+// AppendBlock adds a nested block to the end of a compiled resource or data source configuration, as the compile
+// helpers only produce attribute syntax
+func AppendBlock(config, blockName string, fieldMaps ...map[string]interface{}) string {
+	return AppendBlocks(config, Block(blockName, fieldMaps...))
+}
+
+// AppendBlocks adds compiled blocks to the end of a compiled configuration or block
+func AppendBlocks(config string, blocks ...string) string {
+	idx := strings.LastIndex(config, "}")
+	if idx == -1 {
+		return config
+	}
+	return config[:idx] + strings.Join(blocks, "\n") + "\n" + config[idx:]
+}
+
 func ProviderConfigWith(extra ...map[string]interface{}) string {
 	return at.CompileProviderConfig(
 		fields.ProviderName,
@@ -27,8 +49,6 @@ func ProviderConfigLocalhostWith(extra ...map[string]interface{}) string {
 
 					fields.ConfigAttrAddresses:             []string{"https://127.0.0.1:9200"},
 					fields.ConfigAttrInsecureSkipTLSVerify: true,
-					fields.ConfigAttrUsername:              os.Getenv("OPENSEARCH_USERNAME"),
-					fields.ConfigAttrPassword:              os.Getenv("OPENSEARCH_PASSWORD"),
 					fields.ConfigAttrRequestTraceLogger: map[string]interface{}{
 						fields.ConfigAttrEnabled:             true,
 						fields.ConfigAttrIncludeRequestBody:  true,
@@ -62,3 +82,155 @@ func PluginSecurityRoleValidConfigWith(name string, extra ...map[string]interfac
 		)...,
 	)
 }
+
+func PluginSecurityUserConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginUser),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityUserValidConfigWith(name string, extra ...map[string]interface{}) string {
+	return PluginSecurityUserConfigWith(
+		name,
+		append(
+			[]map[string]interface{}{
+				{
+					fields.ResourceAttrUsername: name,
+					fields.ResourceAttrPassword: "Th1s-is-@-t3st-password",
+				},
+			},
+			extra...,
+		)...,
+	)
+}
+
+func PluginSecurityRoleMappingConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginRoleMapping),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityRoleMappingValidConfigWith(name string, extra ...map[string]interface{}) string {
+	return PluginSecurityRoleMappingConfigWith(
+		name,
+		append(
+			[]map[string]interface{}{
+				{
+					fields.ResourceAttrRoleName: name,
+				},
+			},
+			extra...,
+		)...,
+	)
+}
+
+func PluginSecurityActionGroupConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginActionGroup),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityActionGroupValidConfigWith(name string, extra ...map[string]interface{}) string {
+	return PluginSecurityActionGroupConfigWith(
+		name,
+		append(
+			[]map[string]interface{}{
+				{
+					fields.ResourceAttrActionGroupName: name,
+					fields.ResourceAttrAllowedActions:  []string{"indices:data/read/search*"},
+				},
+			},
+			extra...,
+		)...,
+	)
+}
+
+func PluginSecurityTenantConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginTenant),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityTenantValidConfigWith(name string, extra ...map[string]interface{}) string {
+	return PluginSecurityTenantConfigWith(
+		name,
+		append(
+			[]map[string]interface{}{
+				{
+					fields.ResourceAttrTenantName: name,
+				},
+			},
+			extra...,
+		)...,
+	)
+}
+
+func PluginSecurityAuditConfigConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginAuditConfig),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityConfigConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginConfig),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityNodesDNConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginNodesDN),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityNodesDNValidConfigWith(name string, extra ...map[string]interface{}) string {
+	return PluginSecurityNodesDNConfigWith(
+		name,
+		append(
+			[]map[string]interface{}{
+				{
+					fields.ResourceAttrClusterName: name,
+				},
+			},
+			extra...,
+		)...,
+	)
+}
+
+func PluginSecurityAllowlistConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileResourceConfig(
+		fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginAllowlist),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityRoleDataSourceConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileDataSourceConfig(
+		fields.TypeName(fields.ProviderName, fields.DataSourceTypeSecurityPluginRole),
+		name,
+		extra...,
+	)
+}
+
+func PluginSecurityRolesDataSourceConfigWith(name string, extra ...map[string]interface{}) string {
+	return at.CompileDataSourceConfig(
+		fields.TypeName(fields.ProviderName, fields.DataSourceTypeSecurityPluginRoles),
+		name,
+		extra...,
+	)
+}
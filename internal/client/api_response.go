@@ -1,7 +1,11 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-multierror"
@@ -9,8 +13,9 @@ import (
 )
 
 type APIStatusResponseErrorRootCause struct {
-	Type   string `json:"type"`
-	Reason string `json:"reason"`
+	Type     string                           `json:"type"`
+	Reason   string                           `json:"reason"`
+	CausedBy *APIStatusResponseErrorRootCause `json:"caused_by,omitempty"`
 }
 
 func (e APIStatusResponseErrorRootCause) Error() string {
@@ -21,28 +26,58 @@ func (e APIStatusResponseErrorRootCause) String() string {
 	if e.Type == "" && e.Reason == "" {
 		return ""
 	}
-	return fmt.Sprintf("type=%q; reason=%q", e.Type, e.Reason)
+	s := fmt.Sprintf("type=%q; reason=%q", e.Type, e.Reason)
+	if e.CausedBy != nil {
+		if cb := e.CausedBy.String(); cb != "" {
+			s = fmt.Sprintf("%s; caused_by=[%s]", s, cb)
+		}
+	}
+	return s
 }
 
 type APIStatusResponseError struct {
+	Type      string                            `json:"type"`
+	Reason    string                            `json:"reason"`
+	CausedBy  *APIStatusResponseErrorRootCause  `json:"caused_by"`
 	RootCause []APIStatusResponseErrorRootCause `json:"root_cause"`
 }
 
-func (e APIStatusResponseError) Error() string {
-	// if no errors, return empty
-	if len(e.RootCause) == 0 {
-		return ""
+// UnmarshalJSON allows for the "error" field to be either an object or a plain string, both of which are returned by
+// different OpenSearch APIs.
+func (e *APIStatusResponseError) UnmarshalJSON(b []byte) error {
+	var reason string
+	if err := json.Unmarshal(b, &reason); err == nil {
+		*e = APIStatusResponseError{Reason: reason}
+		return nil
+	}
+	type alias APIStatusResponseError
+	return json.Unmarshal(b, (*alias)(e))
+}
+
+// cause returns the top-level error as a root cause type, including the caused_by chain
+func (e APIStatusResponseError) cause() APIStatusResponseErrorRootCause {
+	return APIStatusResponseErrorRootCause{
+		Type:     e.Type,
+		Reason:   e.Reason,
+		CausedBy: e.CausedBy,
 	}
+}
 
+func (e APIStatusResponseError) Error() string {
 	// create container for our ultimate error
 	var finalErr error
 
-	// append each error to a multierr
+	// start with the top-level error, if provided
+	if top := e.cause(); top.String() != "" {
+		finalErr = multierror.Append(finalErr, top)
+	}
+
+	// append each root cause to a multierr
 	for _, cause := range e.RootCause {
 		finalErr = multierror.Append(finalErr, cause)
 	}
 
-	// should not be possible, but check just in case
+	// if no errors, return empty
 	if finalErr == nil {
 		return ""
 	}
@@ -57,6 +92,44 @@ type APIStatusResponse struct {
 	APIError *APIStatusResponseError `json:"error"`
 
 	WarningsHeader []string `json:"-"`
+	StatusCode     int      `json:"-"`
+	Method         string   `json:"-"`
+	Path           string   `json:"-"`
+}
+
+// UnmarshalJSON allows for the "status" field to be either a string or a number.  The security plugin APIs return
+// values such as "NOT_FOUND", whereas core OpenSearch APIs return the numeric HTTP status code.
+func (e *APIStatusResponse) UnmarshalJSON(b []byte) error {
+	type alias APIStatusResponse
+	tmp := struct {
+		*alias
+		Status json.RawMessage `json:"status"`
+	}{
+		alias: (*alias)(e),
+	}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+	e.Status = ""
+	if len(tmp.Status) > 0 && string(tmp.Status) != "null" {
+		if err := json.Unmarshal(tmp.Status, &e.Status); err != nil {
+			var n json.Number
+			if err = json.Unmarshal(tmp.Status, &n); err != nil {
+				return fmt.Errorf("unable to decode status field: %w", err)
+			}
+			e.Status = n.String()
+		}
+	}
+	return nil
+}
+
+// IsNotFound returns true if the provided error is an API response indicating the requested object does not exist
+func IsNotFound(err error) bool {
+	var m *APIStatusResponse
+	if errors.As(err, &m) {
+		return m.StatusCode == http.StatusNotFound
+	}
+	return false
 }
 
 func (e APIStatusResponse) populated() bool {
@@ -64,14 +137,38 @@ func (e APIStatusResponse) populated() bool {
 }
 
 func (e APIStatusResponse) HasErrors() bool {
-	return e.APIError != nil && len(e.APIError.RootCause) > 0
+	return e.APIError != nil && (len(e.APIError.RootCause) > 0 || e.APIError.Type != "" || e.APIError.Reason != "")
+}
+
+// request returns a short description of the request that produced this response, if known
+func (e APIStatusResponse) request() string {
+	bits := make([]string, 0, 2)
+	if e.Method != "" {
+		bits = append(bits, e.Method)
+	}
+	if e.Path != "" {
+		bits = append(bits, e.Path)
+	}
+	return strings.Join(bits, " ")
 }
 
 func (e APIStatusResponse) Error() string {
-	if e.APIError == nil {
-		return ""
+	bits := make([]string, 0, 4)
+	if req := e.request(); req != "" {
+		bits = append(bits, req)
+	}
+	if e.StatusCode != 0 {
+		bits = append(bits, fmt.Sprintf("status_code=%d", e.StatusCode))
 	}
-	return e.APIError.Error()
+	if e.Message != "" {
+		bits = append(bits, fmt.Sprintf("message=%q", e.Message))
+	}
+	if e.APIError != nil {
+		if s := e.APIError.Error(); s != "" {
+			bits = append(bits, s)
+		}
+	}
+	return strings.Join(bits, ": ")
 }
 
 func (e APIStatusResponse) String() string {
@@ -80,6 +177,12 @@ func (e APIStatusResponse) String() string {
 
 	// check each field for "non-empty"
 
+	if req := e.request(); req != "" {
+		bits = append(bits, fmt.Sprintf("request=%q", req))
+	}
+	if e.StatusCode != 0 {
+		bits = append(bits, fmt.Sprintf("status_code=%d", e.StatusCode))
+	}
 	if e.Status != "" {
 		bits = append(bits, fmt.Sprintf("status=%q", e.Status))
 	}
@@ -92,6 +195,9 @@ func (e APIStatusResponse) String() string {
 		}
 	}
 	if e.HasErrors() {
+		if top := e.APIError.cause(); top.String() != "" {
+			bits = append(bits, fmt.Sprintf("error=[%s]", top))
+		}
 		for i, rc := range e.APIError.RootCause {
 			bits = append(
 				bits,
@@ -108,7 +214,70 @@ func (e APIStatusResponse) String() string {
 	return ""
 }
 
-func (e APIStatusResponse) AppendDiagnostics(d diag.Diagnostics) {
+// diagnosticSummary returns a one-line summary of the error suitable for use as a diagnostic summary
+func (e APIStatusResponse) diagnosticSummary() string {
+	var reason string
+	if e.APIError != nil && e.APIError.Type != "" {
+		reason = e.APIError.Type
+	} else if e.Status != "" {
+		reason = e.Status
+	} else if e.APIError != nil && len(e.APIError.RootCause) > 0 {
+		reason = e.APIError.RootCause[0].Type
+	}
+	if e.StatusCode != 0 {
+		code := strconv.Itoa(e.StatusCode)
+		if txt := http.StatusText(e.StatusCode); txt != "" {
+			code = fmt.Sprintf("%s %s", code, txt)
+		}
+		if reason == "" {
+			return fmt.Sprintf("OpenSearch API error (%s)", code)
+		}
+		return fmt.Sprintf("OpenSearch API error (%s): %s", code, reason)
+	}
+	if reason == "" {
+		return "OpenSearch API error"
+	}
+	return fmt.Sprintf("OpenSearch API error: %s", reason)
+}
+
+// diagnosticDetail returns a multi-line description of the error, including the full caused_by chain of each cause
+func (e APIStatusResponse) diagnosticDetail() string {
+	var b strings.Builder
+
+	writeCause := func(label string, c APIStatusResponseErrorRootCause) {
+		fmt.Fprintf(&b, "%s: type=%q reason=%q\n", label, c.Type, c.Reason)
+		for depth, cb := 1, c.CausedBy; cb != nil; depth, cb = depth+1, cb.CausedBy {
+			fmt.Fprintf(&b, "%sCaused by: type=%q reason=%q\n", strings.Repeat("  ", depth), cb.Type, cb.Reason)
+		}
+	}
+
+	if req := e.request(); req != "" {
+		fmt.Fprintf(&b, "Request: %s\n", req)
+	}
+	if e.StatusCode != 0 {
+		fmt.Fprintf(&b, "HTTP Status: %d\n", e.StatusCode)
+	}
+	if e.Status != "" {
+		fmt.Fprintf(&b, "Status: %s\n", e.Status)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, "Message: %s\n", e.Message)
+	}
+	if e.APIError != nil {
+		if top := e.APIError.cause(); top.String() != "" {
+			writeCause("Error", top)
+		}
+		for _, rc := range e.APIError.RootCause {
+			writeCause("Root Cause", rc)
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// AppendDiagnostics appends any warnings returned by OpenSearch, and a single error describing the failed request if
+// the response contains errors, to the provided diagnostics.
+func (e *APIStatusResponse) AppendDiagnostics(d *diag.Diagnostics) {
 	// add warnings from header
 	for _, w := range e.WarningsHeader {
 		d.AddWarning(
@@ -116,13 +285,11 @@ func (e APIStatusResponse) AppendDiagnostics(d diag.Diagnostics) {
 			w,
 		)
 	}
-	// add any / all errors
-	if e.HasErrors() {
-		for _, source := range e.APIError.RootCause {
-			d.AddError(
-				source.Type,
-				source.Reason,
-			)
-		}
+	// add error, if present
+	if e.HasErrors() || e.StatusCode >= http.StatusBadRequest {
+		d.AddError(
+			e.diagnosticSummary(),
+			e.diagnosticDetail(),
+		)
 	}
 }
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func testResponse(code int, path, body string) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{Path: path},
+		},
+	}
+}
+
+func TestUnit_ParseResponse(t *testing.T) {
+	t.Run("core-error", func(t *testing.T) {
+		const body = `{"error":{"root_cause":[{"type":"index_not_found_exception","reason":"no such index [nope]"}],"type":"index_not_found_exception","reason":"no such index [nope]","caused_by":{"type":"illegal_state_exception","reason":"inner"}},"status":404}`
+
+		err := ParseResponse(buildOpenSearchAPIResponse(testResponse(http.StatusNotFound, "/nope", body)), nil, http.StatusOK)
+		m, ok := err.(*APIStatusResponse)
+		if !ok {
+			t.Fatalf("expected *APIStatusResponse, saw %T: %v", err, err)
+		}
+		if m.Status != "404" {
+			t.Errorf("expected status %q, saw %q", "404", m.Status)
+		}
+		if m.Method != http.MethodGet || m.Path != "/nope" {
+			t.Errorf("expected request %q, saw %q", "GET /nope", m.request())
+		}
+		if !IsNotFound(err) {
+			t.Error("expected IsNotFound to be true")
+		}
+
+		var diags diag.Diagnostics
+		m.AppendDiagnostics(&diags)
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("expected 1 error diagnostic, saw %d", diags.ErrorsCount())
+		}
+		detail := diags.Errors()[0].Detail()
+		for _, want := range []string{"GET /nope", "404", "no such index [nope]", "illegal_state_exception", "Status Code Mismatch"} {
+			if !strings.Contains(detail, want) {
+				t.Errorf("expected detail to contain %q, saw:\n%s", want, detail)
+			}
+		}
+	})
+
+	t.Run("security-plugin-error", func(t *testing.T) {
+		const body = `{"status":"NOT_FOUND","message":"Resource 'nope' not found."}`
+
+		err := ParseResponse(buildOpenSearchAPIResponse(testResponse(http.StatusNotFound, "/_plugins/_security/api/roles/nope", body)), nil, http.StatusOK)
+		if !IsNotFound(err) {
+			t.Fatalf("expected IsNotFound to be true for %v", err)
+		}
+		if !strings.Contains(err.Error(), "Resource 'nope' not found.") {
+			t.Errorf("expected error to contain message, saw %q", err.Error())
+		}
+	})
+
+	t.Run("string-error", func(t *testing.T) {
+		const body = `{"error":"Incorrect HTTP method for uri [/x] and method [PUT], allowed: [GET]","status":405}`
+
+		err := ParseResponse(buildOpenSearchAPIResponse(testResponse(http.StatusMethodNotAllowed, "/x", body)), nil, http.StatusOK)
+		if IsNotFound(err) {
+			t.Error("expected IsNotFound to be false")
+		}
+		if !strings.Contains(err.Error(), "Incorrect HTTP method") {
+			t.Errorf("expected error to contain reason, saw %q", err.Error())
+		}
+	})
+
+	t.Run("non-json-body", func(t *testing.T) {
+		err := ParseResponse(buildOpenSearchAPIResponse(testResponse(http.StatusBadGateway, "/x", "<html>bad gateway</html>")), nil, http.StatusOK)
+		m, ok := err.(*APIStatusResponse)
+		if !ok {
+			t.Fatalf("expected *APIStatusResponse, saw %T: %v", err, err)
+		}
+		if m.StatusCode != http.StatusBadGateway {
+			t.Errorf("expected status code %d, saw %d", http.StatusBadGateway, m.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	AWSServiceOpenSearch           = "es"
+	AWSServiceOpenSearchServerless = "aoss"
+
+	headerAmzContentSHA256 = "X-Amz-Content-Sha256"
+)
+
+// AWSSigV4Transport is an http.RoundTripper that signs each request with AWS Signature Version 4, as required by
+// Amazon OpenSearch Service domains and OpenSearch Serverless collections using IAM authentication
+type AWSSigV4Transport struct {
+	transport   http.RoundTripper
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	region      string
+	service     string
+
+	now func() time.Time
+}
+
+// NewAWSSigV4Transport constructs a transport signing requests with credentials from the provided provider.  The
+// provider is wrapped in an aws.CredentialsCache if it is not one already, so that credentials are only retrieved
+// again shortly before they expire.
+func NewAWSSigV4Transport(transport http.RoundTripper, credentials aws.CredentialsProvider, region, service string) *AWSSigV4Transport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if service == "" {
+		service = AWSServiceOpenSearch
+	}
+	if _, ok := credentials.(*aws.CredentialsCache); !ok {
+		credentials = aws.NewCredentialsCache(credentials)
+	}
+	rt := AWSSigV4Transport{
+		transport:   transport,
+		credentials: credentials,
+		signer:      v4.NewSigner(),
+		region:      region,
+		service:     service,
+		now:         time.Now,
+	}
+	return &rt
+}
+
+// Unwrap returns the underlying transport
+func (rt *AWSSigV4Transport) Unwrap() http.RoundTripper {
+	return rt.transport
+}
+
+func (rt *AWSSigV4Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	creds, err := rt.credentials.Retrieve(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, fmt.Errorf("error retrieving AWS credentials: %w", err)
+	}
+
+	signed := req.Clone(req.Context())
+
+	// read body to compute payload hash, replacing it with a buffered copy
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		signed.Body = io.NopCloser(bytes.NewReader(body))
+		signed.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		signed.ContentLength = int64(len(body))
+	}
+
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+
+	// serverless collections require the payload hash to be sent
+	if rt.service == AWSServiceOpenSearchServerless {
+		signed.Header.Set(headerAmzContentSHA256, payloadHash)
+	}
+
+	if err = rt.signer.SignHTTP(req.Context(), creds, signed, payloadHash, rt.service, rt.region, rt.now().UTC()); err != nil {
+		return nil, fmt.Errorf("error signing request: %w", err)
+	}
+
+	return rt.transport.RoundTrip(signed)
+}
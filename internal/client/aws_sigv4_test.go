@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+var testAWSCredentials = credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "")
+
+func TestUnit_AWSSigV4Transport(t *testing.T) {
+	t.Run("get-vanilla", func(t *testing.T) {
+		// "get-vanilla" from the AWS Signature Version 4 test suite
+		var seen http.Header
+		rt := NewAWSSigV4Transport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			seen = req.Header.Clone()
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		}), testAWSCredentials, "us-east-1", "service")
+		rt.now = func() time.Time { return time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC) }
+
+		req, _ := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+		if _, err := rt.RoundTrip(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		const expected = "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+		if actual := seen.Get(headerAuthorization); actual != expected {
+			t.Errorf("expected Authorization header:\n%s\nsaw:\n%s", expected, actual)
+		}
+	})
+
+	for _, service := range []string{AWSServiceOpenSearch, AWSServiceOpenSearchServerless} {
+		t.Run(service, func(t *testing.T) {
+			const reqBody = `{"cluster_permissions":[]}`
+			var (
+				seen     http.Header
+				seenBody string
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = r.Header.Clone()
+				b, _ := io.ReadAll(r.Body)
+				seenBody = string(b)
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(srv.Close)
+
+			creds := credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "secret", "session-token")
+			rt := NewAWSSigV4Transport(nil, creds, "us-east-1", service)
+
+			req, _ := http.NewRequest(http.MethodPut, srv.URL+"/_plugins/_security/api/roles/my%20role?pretty=true&a=b", strings.NewReader(reqBody))
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if v := seen.Get(headerAuthorization); !strings.Contains(v, "/us-east-1/"+service+"/aws4_request") {
+				t.Errorf("unexpected Authorization header: %q", v)
+			}
+			if v := seen.Get("X-Amz-Security-Token"); v != "session-token" {
+				t.Errorf("expected session token to be sent, saw %q", v)
+			}
+			if _, ok := seen[headerAmzContentSHA256]; ok != (service == AWSServiceOpenSearchServerless) {
+				t.Errorf("expected %s header only for %s, saw %v", headerAmzContentSHA256, AWSServiceOpenSearchServerless, seen)
+			}
+			if seenBody != reqBody {
+				t.Errorf("expected body %q, saw %q", reqBody, seenBody)
+			}
+		})
+	}
+
+	t.Run("credentials-error", func(t *testing.T) {
+		creds := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{}, errors.New("no credentials")
+		})
+		rt := NewAWSSigV4Transport(nil, creds, "us-east-1", AWSServiceOpenSearch)
+		req, _ := http.NewRequest(http.MethodGet, "http://127.0.0.1/", nil)
+		if _, err := rt.RoundTrip(req); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
@@ -1,7 +1,8 @@
 package client
 
 const (
-	headerContentType = "Content-Type"
+	headerContentType   = "Content-Type"
+	headerAuthorization = "Authorization"
 )
 
 var (
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultCredentialProcessTimeout = 30 * time.Second
+
+	// credentialProcessExpiryWindow is how long before their expiration credentials are refreshed
+	credentialProcessExpiryWindow = time.Minute
+
+	// credentialProcessStderrLimit is the maximum number of bytes of stderr included in an error
+	credentialProcessStderrLimit = 1024
+)
+
+// CredentialProcessCredentials is the JSON document a credential process must write to stdout.  Either Token, or
+// both Username and Password, must be set.  Credentials without an Expiration are cached for the life of the
+// provider.
+type CredentialProcessCredentials struct {
+	Username   string    `json:"username"`
+	Password   string    `json:"password"`
+	Token      string    `json:"token"`
+	Expiration time.Time `json:"expiration"`
+}
+
+func (c CredentialProcessCredentials) validate() error {
+	if c.Token != "" {
+		if c.Username != "" || c.Password != "" {
+			return errors.New("output must contain either a token or a username and password, not both")
+		}
+		return nil
+	}
+	if c.Username == "" || c.Password == "" {
+		return errors.New("output must contain either a token or a username and password")
+	}
+	return nil
+}
+
+// CredentialProcess runs an external command to obtain credentials, caching them until shortly before they expire
+type CredentialProcess struct {
+	// Command is the executable to run, followed by its arguments
+	Command []string
+	// Env is added to the environment of the provider when running the command
+	Env map[string]string
+	// Timeout limits how long the command may run.  Defaults to DefaultCredentialProcessTimeout.
+	Timeout time.Duration
+
+	mu    sync.Mutex
+	creds CredentialProcessCredentials
+	now   func() time.Time
+}
+
+func (p *CredentialProcess) Credentials(ctx context.Context) (CredentialProcessCredentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now
+	if p.now != nil {
+		now = p.now
+	}
+
+	if p.creds.validate() == nil && (p.creds.Expiration.IsZero() || now().Add(credentialProcessExpiryWindow).Before(p.creds.Expiration)) {
+		return p.creds, nil
+	}
+
+	creds, err := p.run(ctx)
+	if err != nil {
+		return CredentialProcessCredentials{}, err
+	}
+	if !creds.Expiration.IsZero() && !now().Before(creds.Expiration) {
+		return CredentialProcessCredentials{}, fmt.Errorf("credential process returned credentials that expired at %s", creds.Expiration.Format(time.RFC3339))
+	}
+
+	p.creds = creds
+	return creds, nil
+}
+
+func (p *CredentialProcess) run(ctx context.Context) (CredentialProcessCredentials, error) {
+	if len(p.Command) == 0 || p.Command[0] == "" {
+		return CredentialProcessCredentials{}, errors.New("credential process command is empty")
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultCredentialProcessTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Command[0], p.Command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = os.Environ()
+	for k, v := range p.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > credentialProcessStderrLimit {
+			msg = msg[:credentialProcessStderrLimit] + "..."
+		}
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		if msg != "" {
+			return CredentialProcessCredentials{}, fmt.Errorf("error running credential process %q: %w: %s", p.Command[0], err, msg)
+		}
+		return CredentialProcessCredentials{}, fmt.Errorf("error running credential process %q: %w", p.Command[0], err)
+	}
+
+	var creds CredentialProcessCredentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return CredentialProcessCredentials{}, fmt.Errorf("error decoding output of credential process %q: %w", p.Command[0], err)
+	}
+	if err := creds.validate(); err != nil {
+		return CredentialProcessCredentials{}, fmt.Errorf("invalid output from credential process %q: %w", p.Command[0], err)
+	}
+
+	return creds, nil
+}
+
+// CredentialProcessTransport is an http.RoundTripper that authenticates each request with credentials obtained from
+// a CredentialProcess, using a bearer token or HTTP basic authentication
+type CredentialProcessTransport struct {
+	transport http.RoundTripper
+	process   *CredentialProcess
+}
+
+func NewCredentialProcessTransport(transport http.RoundTripper, process *CredentialProcess) *CredentialProcessTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	rt := CredentialProcessTransport{
+		transport: transport,
+		process:   process,
+	}
+	return &rt
+}
+
+// Unwrap returns the underlying transport
+func (rt *CredentialProcessTransport) Unwrap() http.RoundTripper {
+	return rt.transport
+}
+
+func (rt *CredentialProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	creds, err := rt.process.Credentials(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+
+	out := req.Clone(req.Context())
+	if creds.Token != "" {
+		out.Header.Set(headerAuthorization, "Bearer "+creds.Token)
+	} else {
+		out.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	return rt.transport.RoundTrip(out)
+}
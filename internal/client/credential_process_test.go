@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCredentialProcessScript writes a shell script that prints the provided output, counting its invocations in a
+// file alongside it
+func testCredentialProcessScript(t *testing.T, output string) (string, string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process tests require a POSIX shell")
+	}
+	dir := t.TempDir()
+	script := filepath.Join(dir, "creds.sh")
+	counter := filepath.Join(dir, "calls")
+	body := "#!/bin/sh\necho x >> \"" + counter + "\"\n" + output + "\n"
+	if err := os.WriteFile(script, []byte(body), 0700); err != nil {
+		t.Fatalf("error writing script: %v", err)
+	}
+	return script, counter
+}
+
+func testCredentialProcessCalls(t *testing.T, counter string) int {
+	t.Helper()
+	b, err := os.ReadFile(counter)
+	if err != nil {
+		return 0
+	}
+	return strings.Count(string(b), "x")
+}
+
+func TestUnit_CredentialProcess(t *testing.T) {
+	t.Run("caches-until-expiry", func(t *testing.T) {
+		script, counter := testCredentialProcessScript(t, `printf '{"username":"%s","password":"secret","expiration":"%s"}' "$OS_USER" "$OS_EXPIRATION"`)
+
+		now := time.Now()
+		p := &CredentialProcess{
+			Command: []string{script},
+			Env: map[string]string{
+				"OS_USER":       "ci",
+				"OS_EXPIRATION": now.Add(10 * time.Minute).UTC().Format(time.RFC3339),
+			},
+			now: func() time.Time { return now },
+		}
+
+		for i := 0; i < 2; i++ {
+			creds, err := p.Credentials(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if creds.Username != "ci" || creds.Password != "secret" {
+				t.Errorf("unexpected credentials: %+v", creds)
+			}
+		}
+		if n := testCredentialProcessCalls(t, counter); n != 1 {
+			t.Errorf("expected credentials to be cached after 1 run, saw %d runs", n)
+		}
+
+		// within the expiry window
+		now = now.Add(9*time.Minute + 30*time.Second)
+		p.Env["OS_EXPIRATION"] = now.Add(10 * time.Minute).UTC().Format(time.RFC3339)
+		if _, err := p.Credentials(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := testCredentialProcessCalls(t, counter); n != 2 {
+			t.Errorf("expected credentials to be refreshed, saw %d runs", n)
+		}
+	})
+
+	t.Run("invalid-output-throws-error", func(t *testing.T) {
+		for name, output := range map[string]string{
+			"not-json":      `echo nope`,
+			"no-password":   `echo '{"username":"ci"}'`,
+			"both":          `echo '{"username":"ci","password":"secret","token":"t"}'`,
+			"expired":       `echo '{"token":"t","expiration":"2000-01-01T00:00:00Z"}'`,
+			"non-zero-exit": `echo "vault: permission denied" >&2; exit 2`,
+		} {
+			script, _ := testCredentialProcessScript(t, output)
+			if _, err := (&CredentialProcess{Command: []string{script}}).Credentials(context.Background()); err == nil {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		script, _ := testCredentialProcessScript(t, `exec sleep 5`)
+		p := &CredentialProcess{Command: []string{script}, Timeout: 100 * time.Millisecond}
+		if _, err := p.Credentials(context.Background()); err == nil || !strings.Contains(err.Error(), "deadline") {
+			t.Fatalf("expected deadline error, saw %v", err)
+		}
+	})
+}
+
+func TestUnit_CredentialProcessTransport(t *testing.T) {
+	var seen *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	for name, tc := range map[string]struct {
+		output   string
+		expected string
+	}{
+		"token":      {output: `echo '{"token":"vault-token"}'`, expected: "Bearer vault-token"},
+		"basic-auth": {output: `echo '{"username":"ci","password":"secret"}'`, expected: "Basic Y2k6c2VjcmV0"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			script, _ := testCredentialProcessScript(t, tc.output)
+			rt := NewCredentialProcessTransport(nil, &CredentialProcess{Command: []string{script}})
+
+			req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if v := seen.Header.Get(headerAuthorization); v != tc.expected {
+				t.Errorf("expected Authorization %q, saw %q", tc.expected, v)
+			}
+		})
+	}
+}
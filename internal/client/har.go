@@ -0,0 +1,339 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	harVersion     = "1.2"
+	harCreatorName = "terraform-provider-opensearch"
+
+	// harTrailer closes the entries array and log object.  Each entry is written in place of the trailer, which is
+	// then re-written, so the file is valid JSON after each round trip.
+	harTrailer = "\n]}}\n"
+)
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	// Error is a custom field recording requests that failed without a response
+	Error string `json:"_error,omitempty"`
+}
+
+// HARLogger writes each request and response sent by the OpenSearch client, including redacted headers and bodies,
+// to an HTTP Archive (HAR) file that may be loaded into browser developer tools.  Entries are appended if the file
+// already contains an archive written by this logger, so that a single file may span plan and apply.  Terraform may
+// run several provider processes at once, so each write holds an exclusive lock on the file and re-reads the end of
+// the archive before appending to it.
+type HARLogger struct {
+	provCTX  context.Context
+	redactor Redactor
+
+	mu     sync.Mutex
+	f      *os.File
+	err    error
+	closed bool
+}
+
+func NewHARLogger(provCTX context.Context, filename, version string, redactor Redactor) (*HARLogger, error) {
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening HAR file: %w", err)
+	}
+
+	if err = initHARFile(f, version); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	hl := HARLogger{
+		provCTX:  provCTX,
+		redactor: redactor,
+		f:        f,
+	}
+
+	return &hl, nil
+}
+
+// initHARFile starts a new archive in f, unless it already contains one written by a previous run
+func initHARFile(f *os.File, version string) error {
+	if err := lockFile(f); err != nil {
+		return fmt.Errorf("error locking HAR file: %w", err)
+	}
+	defer func() { _ = unlockFile(f) }()
+
+	existing, err := io.ReadAll(f)
+	if err != nil {
+		return fmt.Errorf("error reading HAR file: %w", err)
+	}
+	if bytes.HasPrefix(existing, []byte(`{"log":`)) && bytes.HasSuffix(existing, []byte(harTrailer)) {
+		return nil
+	}
+
+	header, err := json.Marshal(harCreator{Name: harCreatorName, Version: version})
+	if err != nil {
+		return err
+	}
+	if err = f.Truncate(0); err == nil {
+		_, err = f.WriteAt([]byte(fmt.Sprintf(`{"log":{"version":%q,"creator":%s,"entries":[`, harVersion, header)+harTrailer), 0)
+	}
+	if err != nil {
+		return fmt.Errorf("error writing HAR file: %w", err)
+	}
+
+	return nil
+}
+
+func harHeaders(h http.Header) []harNameValue {
+	out := make([]harNameValue, 0, len(h))
+	for k, v := range h {
+		for _, vv := range v {
+			out = append(out, harNameValue{Name: k, Value: vv})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func (hl *HARLogger) entry(req *http.Request, resp *http.Response, err error, start time.Time, dur time.Duration) harEntry {
+	ms := float64(dur) / float64(time.Millisecond)
+
+	e := harEntry{
+		StartedDateTime: start.Format(time.RFC3339Nano),
+		Time:            ms,
+		Timings:         harTimings{Wait: ms},
+		Request: harRequest{
+			HTTPVersion: "HTTP/1.1",
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    0,
+		},
+		Response: harResponse{
+			HTTPVersion: "HTTP/1.1",
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+	}
+
+	if req != nil {
+		e.Request.Method = req.Method
+		e.Request.URL = req.URL.Redacted()
+		if req.Proto != "" {
+			e.Request.HTTPVersion = req.Proto
+		}
+		e.Request.Headers = harHeaders(hl.redactor.Header(req.Header))
+		for k, v := range req.URL.Query() {
+			for _, vv := range v {
+				e.Request.QueryString = append(e.Request.QueryString, harNameValue{Name: k, Value: vv})
+			}
+		}
+		sort.SliceStable(e.Request.QueryString, func(i, j int) bool { return e.Request.QueryString[i].Name < e.Request.QueryString[j].Name })
+		if req.Body != nil {
+			body, _ := io.ReadAll(req.Body)
+			e.Request.BodySize = len(body)
+			if len(body) > 0 {
+				e.Request.PostData = &harPostData{
+					MimeType: req.Header.Get(headerContentType),
+					Text:     string(hl.redactor.Body(body)),
+				}
+			}
+		}
+	}
+
+	if resp != nil && resp.StatusCode != 0 {
+		e.Response.Status = resp.StatusCode
+		e.Response.StatusText = http.StatusText(resp.StatusCode)
+		if resp.Proto != "" {
+			e.Response.HTTPVersion = resp.Proto
+		}
+		e.Response.Headers = harHeaders(hl.redactor.Header(resp.Header))
+		e.Response.Content.MimeType = resp.Header.Get(headerContentType)
+		if resp.Body != nil {
+			body, _ := io.ReadAll(resp.Body)
+			e.Response.BodySize = len(body)
+			e.Response.Content.Size = len(body)
+			e.Response.Content.Text = string(hl.redactor.Body(body))
+		}
+	}
+
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	return e
+}
+
+func (hl *HARLogger) LogRoundTrip(req *http.Request, resp *http.Response, err error, start time.Time, dur time.Duration) error {
+	defer func() {
+		if req != nil && req.Body != nil {
+			_ = req.Body.Close()
+		}
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
+	e := hl.entry(req, resp, err, start, dur)
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	hl.mu.Lock()
+	defer hl.mu.Unlock()
+
+	if hl.closed {
+		return fmt.Errorf("error writing HAR file: %w", os.ErrClosed)
+	}
+
+	// only the first write failure is logged, to avoid flooding the log
+	if hl.err != nil {
+		return hl.err
+	}
+
+	if err = hl.append(b); err != nil {
+		hl.err = fmt.Errorf("error writing HAR file: %w", err)
+		tflog.Warn(hl.provCTX, hl.err.Error(), map[string]interface{}{"har_file": hl.f.Name()})
+		return hl.err
+	}
+
+	return nil
+}
+
+// append writes an entry in place of the trailer.  Other processes may have appended entries since the last write,
+// so the end of the archive is read again once the file is locked.
+func (hl *HARLogger) append(entry []byte) error {
+	if err := lockFile(hl.f); err != nil {
+		return err
+	}
+	defer func() { _ = unlockFile(hl.f) }()
+
+	fi, err := hl.f.Stat()
+	if err != nil {
+		return err
+	}
+
+	// the trailer is preceded by the opening bracket of the entries array, or the end of the previous entry
+	tail := make([]byte, len(harTrailer)+1)
+	offset := fi.Size() - int64(len(harTrailer))
+	if offset < 1 {
+		return errors.New("file no longer contains an archive")
+	}
+	if _, err = hl.f.ReadAt(tail, offset-1); err != nil {
+		return err
+	}
+	if !bytes.HasSuffix(tail, []byte(harTrailer)) {
+		return errors.New("file no longer contains an archive")
+	}
+
+	buf := make([]byte, 0, len(entry)+len(harTrailer)+2)
+	if tail[0] != '[' {
+		buf = append(buf, ',')
+	}
+	buf = append(buf, '\n')
+	buf = append(buf, entry...)
+	buf = append(buf, harTrailer...)
+
+	_, err = hl.f.WriteAt(buf, offset)
+	return err
+}
+
+// Close syncs and closes the HAR file.  Round trips logged afterwards are discarded.
+func (hl *HARLogger) Close() error {
+	hl.mu.Lock()
+	defer hl.mu.Unlock()
+
+	if hl.closed {
+		return nil
+	}
+	hl.closed = true
+
+	err := hl.f.Sync()
+	if cerr := hl.f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("error closing HAR file: %w", err)
+	}
+
+	return nil
+}
+
+func (hl *HARLogger) RequestBodyEnabled() bool {
+	return true
+}
+
+func (hl *HARLogger) ResponseBodyEnabled() bool {
+	return true
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"net/http"
+)
+
+// HeaderTransport is an http.RoundTripper that sets a fixed set of headers on every request, replacing any value
+// already present.  This includes requests built by the custom API types in this package, which otherwise only send
+// the headers set on each request.
+type HeaderTransport struct {
+	transport http.RoundTripper
+	header    http.Header
+}
+
+func NewHeaderTransport(transport http.RoundTripper, header http.Header) *HeaderTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	rt := HeaderTransport{
+		transport: transport,
+		header:    header.Clone(),
+	}
+	return &rt
+}
+
+// Unwrap returns the underlying transport
+func (rt *HeaderTransport) Unwrap() http.RoundTripper {
+	return rt.transport
+}
+
+func (rt *HeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(rt.header) == 0 {
+		return rt.transport.RoundTrip(req)
+	}
+
+	// requests must not be modified by a round tripper, so the headers are set on a copy
+	out := req.Clone(req.Context())
+	for k, v := range rt.header {
+		out.Header[k] = append([]string(nil), v...)
+	}
+
+	return rt.transport.RoundTrip(out)
+}
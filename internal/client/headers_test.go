@@ -0,0 +1,44 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUnit_HeaderTransport(t *testing.T) {
+	var seen http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	header := make(http.Header)
+	header.Set("Authorization", "Bearer token")
+	header.Set("X-Tenant", "global")
+	rt := NewHeaderTransport(nil, header)
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	req.SetBasicAuth("admin", "admin")
+	req.Header.Set("X-Request", "kept")
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if v := seen.Values("Authorization"); len(v) != 1 || v[0] != "Bearer token" {
+		t.Errorf("expected Authorization header to be replaced, saw %v", v)
+	}
+	if v := seen.Get("X-Tenant"); v != "global" {
+		t.Errorf("expected X-Tenant %q, saw %q", "global", v)
+	}
+	if v := seen.Get("X-Request"); v != "kept" {
+		t.Errorf("expected X-Request %q, saw %q", "kept", v)
+	}
+	if v := req.Header.Get("X-Tenant"); v != "" {
+		t.Errorf("expected original request to be unmodified, saw X-Tenant %q", v)
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,18 +21,28 @@ func newOpenSearchRequest(ctx context.Context, method, path string, body io.Read
 }
 
 func addOpenSearchRequestHeaders(req *http.Request, headers http.Header) {
-	l := len(headers)
-	if l == 0 {
-		return
-	}
-	if len(req.Header) == 0 {
-		req.Header = make(http.Header, l)
+	if req.Header == nil {
+		req.Header = make(http.Header, len(headers)+1)
 	}
 	for k, v := range headers {
 		for _, vv := range v {
 			req.Header.Add(k, vv)
 		}
 	}
+	if req.Header.Get(HeaderOpaqueID) == "" {
+		if id := OpaqueIDFromContext(req.Context()); id != "" {
+			req.Header.Set(HeaderOpaqueID, id)
+		}
+	}
+}
+
+// responseBody retains the method and path of the request that produced a response, so that any error parsed from
+// the response may reference them
+type responseBody struct {
+	io.ReadCloser
+
+	method string
+	path   string
 }
 
 func buildOpenSearchAPIResponse(res *http.Response) *opensearchapi.Response {
@@ -40,6 +51,13 @@ func buildOpenSearchAPIResponse(res *http.Response) *opensearchapi.Response {
 		Header:     res.Header,
 		Body:       res.Body,
 	}
+	if res.Body != nil && res.Request != nil && res.Request.URL != nil {
+		response.Body = &responseBody{
+			ReadCloser: res.Body,
+			method:     res.Request.Method,
+			path:       res.Request.URL.Path,
+		}
+	}
 	return &response
 }
 
@@ -60,6 +78,15 @@ func codesToString(in []int) []string {
 	return out
 }
 
+// setResponseDetails records the status code and originating request of a response
+func setResponseDetails(osResp *opensearchapi.Response, m *APIStatusResponse) {
+	m.StatusCode = osResp.StatusCode
+	if rb, ok := osResp.Body.(*responseBody); ok {
+		m.Method = rb.method
+		m.Path = rb.path
+	}
+}
+
 func ParseResponse(osResp *opensearchapi.Response, sink interface{}, okCodes ...int) error {
 	// immediately queue up body closure handling
 	defer HandleResponseCleanup(osResp)
@@ -74,21 +101,27 @@ func ParseResponse(osResp *opensearchapi.Response, sink interface{}, okCodes ...
 		if err := json.NewDecoder(osResp.Body).Decode(sink); err != nil {
 			return err
 		}
-		// if the provided sink is of type *APIStatusResponse, add warnings from header to it
-		if m, ok := sink.(*APIStatusResponse); ok && osResp.HasWarnings() {
-			w := osResp.Warnings()
-			m.WarningsHeader = make([]string, len(w))
-			copy(m.WarningsHeader, w)
+		// if the provided sink is of type *APIStatusResponse, add request details and warnings from header to it
+		if m, ok := sink.(*APIStatusResponse); ok {
+			setResponseDetails(osResp, m)
+			if osResp.HasWarnings() {
+				w := osResp.Warnings()
+				m.WarningsHeader = make([]string, len(w))
+				copy(m.WarningsHeader, w)
+			}
 		}
 		return nil
 	}
 
 	// otherwise, attempt to unmarshal response into meta
 	meta := new(APIStatusResponse)
+	setResponseDetails(osResp, meta)
 
-	// attempt to decode response
-	if err := json.NewDecoder(osResp.Body).Decode(meta); err != nil {
-		return err
+	// attempt to decode response, allowing for an empty body.  non-json bodies, such as those returned by proxies, are
+	// recorded as an error rather than returned so that the status code and request are still reported.
+	var decodeErr error
+	if err := json.NewDecoder(osResp.Body).Decode(meta); err != nil && !errors.Is(err, io.EOF) {
+		decodeErr = err
 	}
 
 	// add any warnings from the header
@@ -103,6 +136,13 @@ func ParseResponse(osResp *opensearchapi.Response, sink interface{}, okCodes ...
 		meta.APIError = new(APIStatusResponseError)
 	}
 
+	if decodeErr != nil {
+		meta.APIError.RootCause = append(meta.APIError.RootCause, APIStatusResponseErrorRootCause{
+			Type:   "Response Decode Error",
+			Reason: fmt.Sprintf("Unable to decode response body: %v", decodeErr),
+		})
+	}
+
 	meta.APIError.RootCause = append(meta.APIError.RootCause, APIStatusResponseErrorRootCause{
 		Type:   "Status Code Mismatch",
 		Reason: fmt.Sprintf("Actual response code %d does not match expected [%s]", osResp.StatusCode, strings.Join(codesToString(okCodes), " ")),
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -8,20 +9,32 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opensearch-project/opensearch-go/opensearchtransport"
 )
 
+const (
+	// DebugLoggerSubsystem is the name of the tflog subsystem the client debug logger writes to.  Its level defaults
+	// to that of the provider, and may be set separately with the TF_LOG_PROVIDER_OPENSEARCH_CLIENT environment
+	// variable.
+	DebugLoggerSubsystem = "client"
+)
+
+// TerraformLogger logs each request and response sent by the OpenSearch client at trace level.  Sensitive headers
+// and JSON values are masked by its Redactor.
 type TerraformLogger struct {
 	provCTX context.Context
 
 	reqBodyEnabled  bool
 	respBodyEnabled bool
+	redactor        Redactor
 }
 
-func NewTerraformLogger(provCTX context.Context, requestBodyEnabled, responseBodyEnabled bool) TerraformLogger {
+func NewTerraformLogger(provCTX context.Context, requestBodyEnabled, responseBodyEnabled bool, redactor Redactor) TerraformLogger {
 	tl := TerraformLogger{
 		provCTX:         provCTX,
 		reqBodyEnabled:  requestBodyEnabled,
 		respBodyEnabled: responseBodyEnabled,
+		redactor:        redactor,
 	}
 	return tl
 }
@@ -44,10 +57,11 @@ func (tl TerraformLogger) LogRoundTrip(req *http.Request, resp *http.Response, e
 	}()
 
 	if req != nil {
-		logFields["url"] = req.URL.String()
+		logFields["url"] = req.URL.Redacted()
+		logFields["request_headers"] = tl.redactor.Header(req.Header)
 		if tl.reqBodyEnabled && req.Body != nil {
 			reqBodyBytes, _ := io.ReadAll(req.Body)
-			logFields["request_body"] = string(reqBodyBytes)
+			logFields["request_body"] = string(tl.redactor.Body(reqBodyBytes))
 			logFields["request_body_len"] = len(reqBodyBytes)
 		}
 	}
@@ -57,7 +71,7 @@ func (tl TerraformLogger) LogRoundTrip(req *http.Request, resp *http.Response, e
 		logFields["response_status"] = resp.Status
 		if tl.respBodyEnabled && resp.Body != nil {
 			respBodyBytes, _ := io.ReadAll(resp.Body)
-			logFields["response_body"] = string(respBodyBytes)
+			logFields["response_body"] = string(tl.redactor.Body(respBodyBytes))
 			logFields["response_body_len"] = len(respBodyBytes)
 		}
 	}
@@ -79,3 +93,115 @@ func (tl TerraformLogger) RequestBodyEnabled() bool {
 func (tl TerraformLogger) ResponseBodyEnabled() bool {
 	return tl.respBodyEnabled
 }
+
+// TerraformDebugLogger logs the metadata of each request and response sent by the OpenSearch client to a tflog
+// subsystem at debug level.  Headers and bodies are never logged.
+type TerraformDebugLogger struct {
+	provCTX context.Context
+}
+
+// NewTerraformDebugLogger creates the DebugLoggerSubsystem subsystem from the provided context, which must be the
+// context passed to the provider's Configure method
+func NewTerraformDebugLogger(provCTX context.Context) TerraformDebugLogger {
+	tl := TerraformDebugLogger{
+		provCTX: tflog.NewSubsystem(provCTX, DebugLoggerSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_OPENSEARCH", DebugLoggerSubsystem)),
+	}
+	return tl
+}
+
+func (tl TerraformDebugLogger) LogRoundTrip(req *http.Request, resp *http.Response, err error, start time.Time, dur time.Duration) error {
+	var (
+		logFields = map[string]interface{}{
+			"start":    start.Format(time.RFC3339),
+			"duration": dur,
+		}
+	)
+
+	if req != nil {
+		logFields["method"] = req.Method
+		logFields["url"] = req.URL.Redacted()
+		logFields["request_content_length"] = req.ContentLength
+	}
+
+	if resp != nil && resp.StatusCode != 0 {
+		logFields["response_code"] = resp.StatusCode
+		logFields["response_content_length"] = resp.ContentLength
+		logFields["response_content_type"] = resp.Header.Get(headerContentType)
+	}
+
+	if err != nil {
+		logFields["err"] = err.Error()
+		tflog.SubsystemDebug(tl.provCTX, DebugLoggerSubsystem, "OpenSearch client request failed", logFields)
+	} else {
+		tflog.SubsystemDebug(tl.provCTX, DebugLoggerSubsystem, "OpenSearch client request", logFields)
+	}
+
+	return nil
+}
+
+func (tl TerraformDebugLogger) RequestBodyEnabled() bool {
+	return false
+}
+
+func (tl TerraformDebugLogger) ResponseBodyEnabled() bool {
+	return false
+}
+
+// MultiLogger sends each round trip to several loggers, as the OpenSearch client accepts only one.  Request and
+// response bodies are read once, and a copy is given to each logger that has enabled them.
+type MultiLogger []opensearchtransport.Logger
+
+func (ml MultiLogger) LogRoundTrip(req *http.Request, resp *http.Response, err error, start time.Time, dur time.Duration) error {
+	var reqBody, respBody []byte
+
+	if req != nil && req.Body != nil && ml.RequestBodyEnabled() {
+		reqBody, _ = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+	}
+	if resp != nil && resp.Body != nil && ml.ResponseBodyEnabled() {
+		respBody, _ = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+	}
+
+	for _, l := range ml {
+		var lreq *http.Request
+		if req != nil {
+			r := *req
+			r.Body = nil
+			if reqBody != nil && l.RequestBodyEnabled() {
+				r.Body = io.NopCloser(bytes.NewReader(reqBody))
+			}
+			lreq = &r
+		}
+		var lresp *http.Response
+		if resp != nil {
+			r := *resp
+			r.Body = nil
+			if respBody != nil && l.ResponseBodyEnabled() {
+				r.Body = io.NopCloser(bytes.NewReader(respBody))
+			}
+			lresp = &r
+		}
+		_ = l.LogRoundTrip(lreq, lresp, err, start, dur)
+	}
+
+	return nil
+}
+
+func (ml MultiLogger) RequestBodyEnabled() bool {
+	for _, l := range ml {
+		if l.RequestBodyEnabled() {
+			return true
+		}
+	}
+	return false
+}
+
+func (ml MultiLogger) ResponseBodyEnabled() bool {
+	for _, l := range ml {
+		if l.ResponseBodyEnabled() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// TokenSource returns the bearer token sent with each request
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// OAuth2TokenSource adapts an oauth2.TokenSource to a TokenSource, accepting only bearer tokens
+type OAuth2TokenSource struct {
+	Source oauth2.TokenSource
+}
+
+func (s OAuth2TokenSource) Token(context.Context) (string, error) {
+	token, err := s.Source.Token()
+	if err != nil {
+		return "", fmt.Errorf("error fetching access token: %w", err)
+	}
+	if t := token.Type(); !strings.EqualFold(t, "bearer") {
+		return "", fmt.Errorf("token response contained unsupported token type %q", t)
+	}
+	return token.AccessToken, nil
+}
+
+// NewOIDCClientCredentialsTokenSource returns a TokenSource fetching access tokens from an OAuth 2.0 / OIDC token
+// endpoint using the client credentials grant, calling the token endpoint with the provided transport.  Tokens are
+// cached until shortly before they expire.
+func NewOIDCClientCredentialsTokenSource(conf clientcredentials.Config, transport http.RoundTripper) OAuth2TokenSource {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: transport})
+	return OAuth2TokenSource{Source: conf.TokenSource(ctx)}
+}
+
+// BearerTokenTransport is an http.RoundTripper that sends a token retrieved from a TokenSource as a bearer token
+// with each request
+type BearerTokenTransport struct {
+	transport http.RoundTripper
+	source    TokenSource
+}
+
+func NewBearerTokenTransport(transport http.RoundTripper, source TokenSource) *BearerTokenTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	rt := BearerTokenTransport{
+		transport: transport,
+		source:    source,
+	}
+	return &rt
+}
+
+// Unwrap returns the underlying transport
+func (rt *BearerTokenTransport) Unwrap() http.RoundTripper {
+	return rt.transport
+}
+
+func (rt *BearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := rt.source.Token(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, fmt.Errorf("error retrieving bearer token: %w", err)
+	}
+
+	out := req.Clone(req.Context())
+	out.Header.Set(headerAuthorization, "Bearer "+token)
+
+	return rt.transport.RoundTrip(out)
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// newOIDCTestServer returns a stand-in token endpoint issuing sequentially numbered tokens valid for expiresIn
+func newOIDCTestServer(t *testing.T, expiresIn int) (*httptest.Server, *int32) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "terraform" || secret != "s3cr3t%2B" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = io.WriteString(w, `{"error":"invalid_client","error_description":"bad client credentials"}`)
+			return
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("scope") != "openid opensearch" || r.PostForm.Get("audience") != "opensearch" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"error":"invalid_request"}`)
+			return
+		}
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set(headerContentType, "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func testOIDCClientCredentialsConfig(tokenURL, clientSecret string) clientcredentials.Config {
+	return clientcredentials.Config{
+		TokenURL:       tokenURL,
+		ClientID:       "terraform",
+		ClientSecret:   clientSecret,
+		Scopes:         []string{"openid", "opensearch"},
+		EndpointParams: url.Values{"audience": {"opensearch"}},
+	}
+}
+
+func TestUnit_OIDCClientCredentialsTokenSource(t *testing.T) {
+	t.Run("caches-until-expiry", func(t *testing.T) {
+		srv, calls := newOIDCTestServer(t, 300)
+		s := NewOIDCClientCredentialsTokenSource(testOIDCClientCredentialsConfig(srv.URL, "s3cr3t+"), nil)
+
+		for i := 0; i < 2; i++ {
+			token, err := s.Token(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if token != "token-1" {
+				t.Errorf("expected cached token %q, saw %q", "token-1", token)
+			}
+		}
+		if n := atomic.LoadInt32(calls); n != 1 {
+			t.Errorf("expected 1 token request, saw %d", n)
+		}
+	})
+
+	t.Run("refreshes-before-expiry", func(t *testing.T) {
+		// tokens expiring within seconds are already due for refresh
+		srv, calls := newOIDCTestServer(t, 5)
+		s := NewOIDCClientCredentialsTokenSource(testOIDCClientCredentialsConfig(srv.URL, "s3cr3t+"), nil)
+
+		for i := 1; i <= 2; i++ {
+			token, err := s.Token(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if expected := fmt.Sprintf("token-%d", i); token != expected {
+				t.Errorf("expected refreshed token %q, saw %q", expected, token)
+			}
+		}
+		if n := atomic.LoadInt32(calls); n != 2 {
+			t.Errorf("expected 2 token requests, saw %d", n)
+		}
+	})
+
+	t.Run("error-response", func(t *testing.T) {
+		srv, _ := newOIDCTestServer(t, 300)
+		s := NewOIDCClientCredentialsTokenSource(testOIDCClientCredentialsConfig(srv.URL, "nope"), nil)
+		if _, err := s.Token(context.Background()); err == nil || !strings.Contains(err.Error(), "invalid_client") {
+			t.Fatalf("expected invalid_client error, saw %v", err)
+		}
+	})
+}
+
+func TestUnit_BearerTokenTransport(t *testing.T) {
+	tokenSrv, _ := newOIDCTestServer(t, 300)
+
+	var seen string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header.Get(headerAuthorization)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	rt := NewBearerTokenTransport(nil, NewOIDCClientCredentialsTokenSource(testOIDCClientCredentialsConfig(tokenSrv.URL, "s3cr3t+"), nil))
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if seen != "Bearer token-1" {
+		t.Errorf("expected bearer token, saw %q", seen)
+	}
+	if v := req.Header.Get(headerAuthorization); v != "" {
+		t.Errorf("expected original request to be unmodified, saw %q", v)
+	}
+}
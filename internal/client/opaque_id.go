@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+)
+
+// HeaderOpaqueID is echoed by OpenSearch in its slow logs, task list and audit log, allowing requests to be traced
+// back to their origin
+const HeaderOpaqueID = "X-Opaque-Id"
+
+type opaqueIDContextKey struct{}
+
+// WithOpaqueID returns a copy of ctx carrying the provided X-Opaque-Id value.  Requests built by the API types in this
+// package send this value unless their Header field already sets one.
+func WithOpaqueID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, opaqueIDContextKey{}, id)
+}
+
+// OpaqueIDFromContext returns the X-Opaque-Id value carried by ctx, if any
+func OpaqueIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(opaqueIDContextKey{}).(string)
+	return id
+}
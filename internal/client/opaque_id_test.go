@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type performFunc func(*http.Request) (*http.Response, error)
+
+func (fn performFunc) Perform(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestUnit_OpaqueID(t *testing.T) {
+	var seen http.Header
+	transport := performFunc(func(req *http.Request) (*http.Response, error) {
+		seen = req.Header.Clone()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Request:    req,
+		}, nil
+	})
+
+	do := func(ctx context.Context, header http.Header) {
+		t.Helper()
+		resp, err := PluginSecurityRolesGetRequest{Name: "my-role", Header: header}.Do(ctx, transport)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		HandleResponseCleanup(resp)
+	}
+
+	t.Run("from-context", func(t *testing.T) {
+		ctx := WithOpaqueID(context.Background(), "terraform/dev/opensearch_security_plugin_role/read")
+		do(ctx, nil)
+		if v := seen.Values(HeaderOpaqueID); len(v) != 1 || v[0] != "terraform/dev/opensearch_security_plugin_role/read" {
+			t.Errorf("unexpected %s header: %v", HeaderOpaqueID, v)
+		}
+	})
+
+	t.Run("request-header-takes-precedence", func(t *testing.T) {
+		ctx := WithOpaqueID(context.Background(), "terraform/dev/opensearch_security_plugin_role/read")
+		do(ctx, http.Header{HeaderOpaqueID: {"explicit"}})
+		if v := seen.Values(HeaderOpaqueID); len(v) != 1 || v[0] != "explicit" {
+			t.Errorf("unexpected %s header: %v", HeaderOpaqueID, v)
+		}
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		do(context.Background(), nil)
+		if _, ok := seen[HeaderOpaqueID]; ok {
+			t.Errorf("expected no %s header, saw %v", HeaderOpaqueID, seen.Values(HeaderOpaqueID))
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityActionGroup struct {
+	ActionGroupName string `json:"-" tfsdk:"-"`
+
+	Description string `json:"description,omitempty" tfsdk:"description"`
+	Type        string `json:"type,omitempty" tfsdk:"type"`
+
+	AllowedActions []string `json:"allowed_actions" tfsdk:"allowed_actions"`
+
+	// these are only populated on GET
+
+	Reserved *bool `json:"reserved,omitempty" tfsdk:"reserved"`
+	Hidden   *bool `json:"hidden,omitempty" tfsdk:"hidden"`
+	Static   *bool `json:"static,omitempty" tfsdk:"static"`
+}
+
+type PluginSecurityActionGroupsAPIResponse map[string]PluginSecurityActionGroup
+
+type PluginSecurityActionGroupsGetRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityActionGroupsGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/actiongroups/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityActionGroupsGet func(o ...func(*PluginSecurityActionGroupsGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityActionGroupsGet) WithContext(v context.Context) func(*PluginSecurityActionGroupsGetRequest) {
+	return func(r *PluginSecurityActionGroupsGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityActionGroupsGet) WithName(v string) func(*PluginSecurityActionGroupsGetRequest) {
+	return func(r *PluginSecurityActionGroupsGetRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityActionGroupsGet) WithHeader(n map[string]string) func(*PluginSecurityActionGroupsGetRequest) {
+	return func(r *PluginSecurityActionGroupsGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityActionGroupDeleteRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityActionGroupDeleteRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/actiongroups/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityActionGroupDelete func(o ...func(*PluginSecurityActionGroupDeleteRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityActionGroupDelete) WithContext(v context.Context) func(*PluginSecurityActionGroupDeleteRequest) {
+	return func(r *PluginSecurityActionGroupDeleteRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityActionGroupDelete) WithName(v string) func(*PluginSecurityActionGroupDeleteRequest) {
+	return func(r *PluginSecurityActionGroupDeleteRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityActionGroupDelete) WithHeader(n map[string]string) func(*PluginSecurityActionGroupDeleteRequest) {
+	return func(r *PluginSecurityActionGroupDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityActionGroupUpsertRequest struct {
+	Name string
+
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityActionGroupUpsertRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/actiongroups/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPut, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityActionGroupUpsert func(o ...func(request *PluginSecurityActionGroupUpsertRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityActionGroupUpsert) WithContext(v context.Context) func(*PluginSecurityActionGroupUpsertRequest) {
+	return func(r *PluginSecurityActionGroupUpsertRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityActionGroupUpsert) WithName(v string) func(request *PluginSecurityActionGroupUpsertRequest) {
+	return func(r *PluginSecurityActionGroupUpsertRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityActionGroupUpsert) WithBody(v io.Reader) func(*PluginSecurityActionGroupUpsertRequest) {
+	return func(r *PluginSecurityActionGroupUpsertRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityActionGroupUpsert) WithHeader(n map[string]string) func(*PluginSecurityActionGroupUpsertRequest) {
+	return func(r *PluginSecurityActionGroupUpsertRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
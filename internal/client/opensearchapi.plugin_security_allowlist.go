@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityAllowlistConfig struct {
+	Enabled  bool                `json:"enabled"`
+	Requests map[string][]string `json:"requests"`
+}
+
+// DefaultPluginSecurityAllowlistConfig returns the allowlist configuration shipped with the security plugin
+func DefaultPluginSecurityAllowlistConfig() PluginSecurityAllowlistConfig {
+	return PluginSecurityAllowlistConfig{
+		Enabled: false,
+		Requests: map[string][]string{
+			"/_cluster/settings": {http.MethodGet},
+			"/_cat/nodes":        {http.MethodGet},
+		},
+	}
+}
+
+type PluginSecurityAllowlistAPIResponse struct {
+	Config PluginSecurityAllowlistConfig `json:"config"`
+}
+
+type PluginSecurityAllowlistGetRequest struct {
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityAllowlistGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = "/_plugins/_security/api/allowlist"
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityAllowlistGet func(o ...func(*PluginSecurityAllowlistGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityAllowlistGet) WithContext(v context.Context) func(*PluginSecurityAllowlistGetRequest) {
+	return func(r *PluginSecurityAllowlistGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityAllowlistGet) WithHeader(n map[string]string) func(*PluginSecurityAllowlistGetRequest) {
+	return func(r *PluginSecurityAllowlistGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityAllowlistUpdateRequest struct {
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityAllowlistUpdateRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = "/_plugins/_security/api/allowlist"
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPut, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityAllowlistUpdate func(o ...func(*PluginSecurityAllowlistUpdateRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityAllowlistUpdate) WithContext(v context.Context) func(*PluginSecurityAllowlistUpdateRequest) {
+	return func(r *PluginSecurityAllowlistUpdateRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityAllowlistUpdate) WithBody(v io.Reader) func(*PluginSecurityAllowlistUpdateRequest) {
+	return func(r *PluginSecurityAllowlistUpdateRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityAllowlistUpdate) WithHeader(n map[string]string) func(*PluginSecurityAllowlistUpdateRequest) {
+	return func(r *PluginSecurityAllowlistUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityAuditSettings struct {
+	EnableRest                  bool     `json:"enable_rest"`
+	DisabledRestCategories      []string `json:"disabled_rest_categories"`
+	EnableTransport             bool     `json:"enable_transport"`
+	DisabledTransportCategories []string `json:"disabled_transport_categories"`
+	ResolveBulkRequests         bool     `json:"resolve_bulk_requests"`
+	LogRequestBody              bool     `json:"log_request_body"`
+	ResolveIndices              bool     `json:"resolve_indices"`
+	ExcludeSensitiveHeaders     bool     `json:"exclude_sensitive_headers"`
+	IgnoreUsers                 []string `json:"ignore_users"`
+	IgnoreRequests              []string `json:"ignore_requests"`
+}
+
+type PluginSecurityComplianceSettings struct {
+	Enabled             bool                `json:"enabled"`
+	InternalConfig      bool                `json:"internal_config"`
+	ExternalConfig      bool                `json:"external_config"`
+	ReadMetadataOnly    bool                `json:"read_metadata_only"`
+	ReadWatchedFields   map[string][]string `json:"read_watched_fields"`
+	ReadIgnoreUsers     []string            `json:"read_ignore_users"`
+	WriteMetadataOnly   bool                `json:"write_metadata_only"`
+	WriteLogDiffs       bool                `json:"write_log_diffs"`
+	WriteWatchedIndices []string            `json:"write_watched_indices"`
+	WriteIgnoreUsers    []string            `json:"write_ignore_users"`
+}
+
+type PluginSecurityAuditConfig struct {
+	Enabled    bool                             `json:"enabled"`
+	Audit      PluginSecurityAuditSettings      `json:"audit"`
+	Compliance PluginSecurityComplianceSettings `json:"compliance"`
+}
+
+// DefaultPluginSecurityAuditConfig returns the audit configuration shipped with the security plugin
+func DefaultPluginSecurityAuditConfig() PluginSecurityAuditConfig {
+	return PluginSecurityAuditConfig{
+		Enabled: true,
+		Audit: PluginSecurityAuditSettings{
+			EnableRest:                  true,
+			DisabledRestCategories:      []string{"AUTHENTICATED", "GRANTED_PRIVILEGES"},
+			EnableTransport:             true,
+			DisabledTransportCategories: []string{"AUTHENTICATED", "GRANTED_PRIVILEGES"},
+			ResolveBulkRequests:         false,
+			LogRequestBody:              true,
+			ResolveIndices:              true,
+			ExcludeSensitiveHeaders:     true,
+			IgnoreUsers:                 []string{"kibanaserver"},
+			IgnoreRequests:              []string{},
+		},
+		Compliance: PluginSecurityComplianceSettings{
+			Enabled:             true,
+			InternalConfig:      true,
+			ExternalConfig:      false,
+			ReadMetadataOnly:    true,
+			ReadWatchedFields:   map[string][]string{},
+			ReadIgnoreUsers:     []string{"kibanaserver"},
+			WriteMetadataOnly:   true,
+			WriteLogDiffs:       false,
+			WriteWatchedIndices: []string{},
+			WriteIgnoreUsers:    []string{"kibanaserver"},
+		},
+	}
+}
+
+type PluginSecurityAuditAPIResponse struct {
+	ReadOnly []string                  `json:"_readonly"`
+	Config   PluginSecurityAuditConfig `json:"config"`
+}
+
+type PluginSecurityAuditGetRequest struct {
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityAuditGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = "/_plugins/_security/api/audit"
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityAuditGet func(o ...func(*PluginSecurityAuditGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityAuditGet) WithContext(v context.Context) func(*PluginSecurityAuditGetRequest) {
+	return func(r *PluginSecurityAuditGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityAuditGet) WithHeader(n map[string]string) func(*PluginSecurityAuditGetRequest) {
+	return func(r *PluginSecurityAuditGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityAuditUpdateRequest struct {
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityAuditUpdateRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = "/_plugins/_security/api/audit/config"
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPut, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityAuditUpdate func(o ...func(*PluginSecurityAuditUpdateRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityAuditUpdate) WithContext(v context.Context) func(*PluginSecurityAuditUpdateRequest) {
+	return func(r *PluginSecurityAuditUpdateRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityAuditUpdate) WithBody(v io.Reader) func(*PluginSecurityAuditUpdateRequest) {
+	return func(r *PluginSecurityAuditUpdateRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityAuditUpdate) WithHeader(n map[string]string) func(*PluginSecurityAuditUpdateRequest) {
+	return func(r *PluginSecurityAuditUpdateRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
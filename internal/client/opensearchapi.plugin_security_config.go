@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityConfigHTTPAuthenticator struct {
+	Type      string                 `json:"type"`
+	Challenge bool                   `json:"challenge"`
+	Config    map[string]interface{} `json:"config"`
+}
+
+type PluginSecurityConfigBackend struct {
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config"`
+}
+
+type PluginSecurityConfigAuthcDomain struct {
+	Description           string                                `json:"description,omitempty"`
+	HTTPEnabled           bool                                  `json:"http_enabled"`
+	TransportEnabled      bool                                  `json:"transport_enabled"`
+	Order                 int64                                 `json:"order"`
+	HTTPAuthenticator     PluginSecurityConfigHTTPAuthenticator `json:"http_authenticator"`
+	AuthenticationBackend PluginSecurityConfigBackend           `json:"authentication_backend"`
+}
+
+type PluginSecurityConfigAuthzDomain struct {
+	Description          string                      `json:"description,omitempty"`
+	HTTPEnabled          bool                        `json:"http_enabled"`
+	TransportEnabled     bool                        `json:"transport_enabled"`
+	AuthorizationBackend PluginSecurityConfigBackend `json:"authorization_backend"`
+}
+
+type PluginSecurityConfigXFF struct {
+	Enabled         bool   `json:"enabled"`
+	InternalProxies string `json:"internalProxies"`
+	RemoteIPHeader  string `json:"remoteIpHeader"`
+}
+
+type PluginSecurityConfigHTTP struct {
+	AnonymousAuthEnabled bool                    `json:"anonymous_auth_enabled"`
+	XFF                  PluginSecurityConfigXFF `json:"xff"`
+}
+
+type PluginSecurityConfigKibana struct {
+	MultitenancyEnabled  bool   `json:"multitenancy_enabled"`
+	PrivateTenantEnabled bool   `json:"private_tenant_enabled"`
+	DefaultTenant        string `json:"default_tenant"`
+	ServerUsername       string `json:"server_username"`
+	Index                string `json:"index"`
+}
+
+type PluginSecurityConfigDynamic struct {
+	DoNotFailOnForbidden bool                                       `json:"do_not_fail_on_forbidden"`
+	HTTP                 PluginSecurityConfigHTTP                   `json:"http"`
+	Kibana               PluginSecurityConfigKibana                 `json:"kibana"`
+	Authc                map[string]PluginSecurityConfigAuthcDomain `json:"authc"`
+	Authz                map[string]PluginSecurityConfigAuthzDomain `json:"authz"`
+}
+
+type PluginSecurityConfig struct {
+	Dynamic PluginSecurityConfigDynamic `json:"dynamic"`
+}
+
+type PluginSecurityConfigAPIResponse struct {
+	Config PluginSecurityConfig `json:"config"`
+}
+
+// PluginSecurityConfigPatchOperation is a single RFC 6902 JSON Patch operation
+type PluginSecurityConfigPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+var pluginSecurityConfigPathEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// PluginSecurityConfigDynamicPath constructs an RFC 6901 JSON Pointer to a key underneath config.dynamic
+func PluginSecurityConfigDynamicPath(keys ...string) string {
+	var b strings.Builder
+	b.WriteString("/config/dynamic")
+	for _, k := range keys {
+		b.WriteRune('/')
+		b.WriteString(pluginSecurityConfigPathEscaper.Replace(k))
+	}
+	return b.String()
+}
+
+type PluginSecurityConfigGetRequest struct {
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityConfigGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = "/_plugins/_security/api/securityconfig"
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityConfigGet func(o ...func(*PluginSecurityConfigGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityConfigGet) WithContext(v context.Context) func(*PluginSecurityConfigGetRequest) {
+	return func(r *PluginSecurityConfigGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityConfigGet) WithHeader(n map[string]string) func(*PluginSecurityConfigGetRequest) {
+	return func(r *PluginSecurityConfigGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityConfigPatchRequest struct {
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityConfigPatchRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = "/_plugins/_security/api/securityconfig"
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPatch, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityConfigPatch func(o ...func(*PluginSecurityConfigPatchRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityConfigPatch) WithContext(v context.Context) func(*PluginSecurityConfigPatchRequest) {
+	return func(r *PluginSecurityConfigPatchRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityConfigPatch) WithBody(v io.Reader) func(*PluginSecurityConfigPatchRequest) {
+	return func(r *PluginSecurityConfigPatchRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityConfigPatch) WithHeader(n map[string]string) func(*PluginSecurityConfigPatchRequest) {
+	return func(r *PluginSecurityConfigPatchRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityNodesDN struct {
+	ClusterName string `json:"-" tfsdk:"-"`
+
+	NodesDN []string `json:"nodes_dn" tfsdk:"nodes_dn"`
+}
+
+type PluginSecurityNodesDNAPIResponse map[string]PluginSecurityNodesDN
+
+type PluginSecurityNodesDNGetRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityNodesDNGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/nodesdn/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityNodesDNGet func(o ...func(*PluginSecurityNodesDNGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityNodesDNGet) WithContext(v context.Context) func(*PluginSecurityNodesDNGetRequest) {
+	return func(r *PluginSecurityNodesDNGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityNodesDNGet) WithName(v string) func(*PluginSecurityNodesDNGetRequest) {
+	return func(r *PluginSecurityNodesDNGetRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityNodesDNGet) WithHeader(n map[string]string) func(*PluginSecurityNodesDNGetRequest) {
+	return func(r *PluginSecurityNodesDNGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityNodesDNDeleteRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityNodesDNDeleteRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/nodesdn/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityNodesDNDelete func(o ...func(*PluginSecurityNodesDNDeleteRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityNodesDNDelete) WithContext(v context.Context) func(*PluginSecurityNodesDNDeleteRequest) {
+	return func(r *PluginSecurityNodesDNDeleteRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityNodesDNDelete) WithName(v string) func(*PluginSecurityNodesDNDeleteRequest) {
+	return func(r *PluginSecurityNodesDNDeleteRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityNodesDNDelete) WithHeader(n map[string]string) func(*PluginSecurityNodesDNDeleteRequest) {
+	return func(r *PluginSecurityNodesDNDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityNodesDNUpsertRequest struct {
+	Name string
+
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityNodesDNUpsertRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/nodesdn/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPut, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityNodesDNUpsert func(o ...func(request *PluginSecurityNodesDNUpsertRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityNodesDNUpsert) WithContext(v context.Context) func(*PluginSecurityNodesDNUpsertRequest) {
+	return func(r *PluginSecurityNodesDNUpsertRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityNodesDNUpsert) WithName(v string) func(request *PluginSecurityNodesDNUpsertRequest) {
+	return func(r *PluginSecurityNodesDNUpsertRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityNodesDNUpsert) WithBody(v io.Reader) func(*PluginSecurityNodesDNUpsertRequest) {
+	return func(r *PluginSecurityNodesDNUpsertRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityNodesDNUpsert) WithHeader(n map[string]string) func(*PluginSecurityNodesDNUpsertRequest) {
+	return func(r *PluginSecurityNodesDNUpsertRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
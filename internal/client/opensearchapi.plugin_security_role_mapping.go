@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityRoleMapping struct {
+	RoleName string `json:"-" tfsdk:"-"`
+
+	Description string `json:"description,omitempty" tfsdk:"description"`
+
+	BackendRoles    []string `json:"backend_roles" tfsdk:"backend_roles"`
+	AndBackendRoles []string `json:"and_backend_roles" tfsdk:"and_backend_roles"`
+	Hosts           []string `json:"hosts" tfsdk:"hosts"`
+	Users           []string `json:"users" tfsdk:"users"`
+
+	// these are only populated on GET
+
+	Reserved *bool `json:"reserved,omitempty" tfsdk:"reserved"`
+	Hidden   *bool `json:"hidden,omitempty" tfsdk:"hidden"`
+}
+
+type PluginSecurityRoleMappingsAPIResponse map[string]PluginSecurityRoleMapping
+
+type PluginSecurityRoleMappingsGetRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityRoleMappingsGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/rolesmapping/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityRoleMappingsGet func(o ...func(*PluginSecurityRoleMappingsGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityRoleMappingsGet) WithContext(v context.Context) func(*PluginSecurityRoleMappingsGetRequest) {
+	return func(r *PluginSecurityRoleMappingsGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityRoleMappingsGet) WithName(v string) func(*PluginSecurityRoleMappingsGetRequest) {
+	return func(r *PluginSecurityRoleMappingsGetRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityRoleMappingsGet) WithHeader(n map[string]string) func(*PluginSecurityRoleMappingsGetRequest) {
+	return func(r *PluginSecurityRoleMappingsGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityRoleMappingDeleteRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityRoleMappingDeleteRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/rolesmapping/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityRoleMappingDelete func(o ...func(*PluginSecurityRoleMappingDeleteRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityRoleMappingDelete) WithContext(v context.Context) func(*PluginSecurityRoleMappingDeleteRequest) {
+	return func(r *PluginSecurityRoleMappingDeleteRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityRoleMappingDelete) WithName(v string) func(*PluginSecurityRoleMappingDeleteRequest) {
+	return func(r *PluginSecurityRoleMappingDeleteRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityRoleMappingDelete) WithHeader(n map[string]string) func(*PluginSecurityRoleMappingDeleteRequest) {
+	return func(r *PluginSecurityRoleMappingDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityRoleMappingUpsertRequest struct {
+	Name string
+
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityRoleMappingUpsertRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/rolesmapping/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPut, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityRoleMappingUpsert func(o ...func(request *PluginSecurityRoleMappingUpsertRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityRoleMappingUpsert) WithContext(v context.Context) func(*PluginSecurityRoleMappingUpsertRequest) {
+	return func(r *PluginSecurityRoleMappingUpsertRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityRoleMappingUpsert) WithName(v string) func(request *PluginSecurityRoleMappingUpsertRequest) {
+	return func(r *PluginSecurityRoleMappingUpsertRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityRoleMappingUpsert) WithBody(v io.Reader) func(*PluginSecurityRoleMappingUpsertRequest) {
+	return func(r *PluginSecurityRoleMappingUpsertRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityRoleMappingUpsert) WithHeader(n map[string]string) func(*PluginSecurityRoleMappingUpsertRequest) {
+	return func(r *PluginSecurityRoleMappingUpsertRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityTenant struct {
+	TenantName string `json:"-" tfsdk:"-"`
+
+	Description string `json:"description" tfsdk:"description"`
+
+	// these are only populated on GET
+
+	Reserved *bool `json:"reserved,omitempty" tfsdk:"reserved"`
+	Hidden   *bool `json:"hidden,omitempty" tfsdk:"hidden"`
+	Static   *bool `json:"static,omitempty" tfsdk:"static"`
+}
+
+type PluginSecurityTenantsAPIResponse map[string]PluginSecurityTenant
+
+type PluginSecurityTenantsGetRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityTenantsGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/tenants/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityTenantsGet func(o ...func(*PluginSecurityTenantsGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityTenantsGet) WithContext(v context.Context) func(*PluginSecurityTenantsGetRequest) {
+	return func(r *PluginSecurityTenantsGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityTenantsGet) WithName(v string) func(*PluginSecurityTenantsGetRequest) {
+	return func(r *PluginSecurityTenantsGetRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityTenantsGet) WithHeader(n map[string]string) func(*PluginSecurityTenantsGetRequest) {
+	return func(r *PluginSecurityTenantsGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityTenantDeleteRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityTenantDeleteRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/tenants/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityTenantDelete func(o ...func(*PluginSecurityTenantDeleteRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityTenantDelete) WithContext(v context.Context) func(*PluginSecurityTenantDeleteRequest) {
+	return func(r *PluginSecurityTenantDeleteRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityTenantDelete) WithName(v string) func(*PluginSecurityTenantDeleteRequest) {
+	return func(r *PluginSecurityTenantDeleteRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityTenantDelete) WithHeader(n map[string]string) func(*PluginSecurityTenantDeleteRequest) {
+	return func(r *PluginSecurityTenantDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityTenantUpsertRequest struct {
+	Name string
+
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityTenantUpsertRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/tenants/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPut, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityTenantUpsert func(o ...func(request *PluginSecurityTenantUpsertRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityTenantUpsert) WithContext(v context.Context) func(*PluginSecurityTenantUpsertRequest) {
+	return func(r *PluginSecurityTenantUpsertRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityTenantUpsert) WithName(v string) func(request *PluginSecurityTenantUpsertRequest) {
+	return func(r *PluginSecurityTenantUpsertRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityTenantUpsert) WithBody(v io.Reader) func(*PluginSecurityTenantUpsertRequest) {
+	return func(r *PluginSecurityTenantUpsertRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityTenantUpsert) WithHeader(n map[string]string) func(*PluginSecurityTenantUpsertRequest) {
+	return func(r *PluginSecurityTenantUpsertRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
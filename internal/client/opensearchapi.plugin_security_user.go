@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+type PluginSecurityUser struct {
+	Username string `json:"-" tfsdk:"-"`
+
+	// these are only sent on PUT
+
+	Password string `json:"password,omitempty" tfsdk:"password"`
+	Hash     string `json:"hash,omitempty" tfsdk:"hash"`
+
+	BackendRoles            []string          `json:"backend_roles" tfsdk:"backend_roles"`
+	OpenDistroSecurityRoles []string          `json:"opendistro_security_roles" tfsdk:"opendistro_security_roles"`
+	Attributes              map[string]string `json:"attributes" tfsdk:"attributes"`
+
+	// these are only populated on GET
+
+	Reserved *bool `json:"reserved,omitempty" tfsdk:"reserved"`
+	Hidden   *bool `json:"hidden,omitempty" tfsdk:"hidden"`
+	Static   *bool `json:"static,omitempty" tfsdk:"static"`
+}
+
+type PluginSecurityUsersAPIResponse map[string]PluginSecurityUser
+
+type PluginSecurityUsersGetRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r PluginSecurityUsersGetRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/internalusers/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodGet, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityUsersGet func(o ...func(*PluginSecurityUsersGetRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityUsersGet) WithContext(v context.Context) func(*PluginSecurityUsersGetRequest) {
+	return func(r *PluginSecurityUsersGetRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityUsersGet) WithName(v string) func(*PluginSecurityUsersGetRequest) {
+	return func(r *PluginSecurityUsersGetRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityUsersGet) WithHeader(n map[string]string) func(*PluginSecurityUsersGetRequest) {
+	return func(r *PluginSecurityUsersGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityUserDeleteRequest struct {
+	Name string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityUserDeleteRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/internalusers/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return nil, err
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityUserDelete func(o ...func(*PluginSecurityUserDeleteRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityUserDelete) WithContext(v context.Context) func(*PluginSecurityUserDeleteRequest) {
+	return func(r *PluginSecurityUserDeleteRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityUserDelete) WithName(v string) func(*PluginSecurityUserDeleteRequest) {
+	return func(r *PluginSecurityUserDeleteRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityUserDelete) WithHeader(n map[string]string) func(*PluginSecurityUserDeleteRequest) {
+	return func(r *PluginSecurityUserDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+type PluginSecurityUserUpsertRequest struct {
+	Name string
+
+	Body io.Reader
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+func (r *PluginSecurityUserUpsertRequest) Do(ctx context.Context, transport opensearchapi.Transport) (*opensearchapi.Response, error) {
+	var (
+		path string
+		req  *http.Request
+		res  *http.Response
+		err  error
+	)
+
+	path = fmt.Sprintf("/_plugins/_security/api/internalusers/%s", r.Name)
+
+	if req, err = newOpenSearchRequest(ctx, http.MethodPut, path, r.Body); err != nil {
+		return nil, err
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	addOpenSearchRequestHeaders(req, r.Header)
+
+	if res, err = transport.Perform(req); err != nil {
+		return nil, err
+	}
+
+	return buildOpenSearchAPIResponse(res), nil
+}
+
+type PluginSecurityUserUpsert func(o ...func(request *PluginSecurityUserUpsertRequest)) (*opensearchapi.Response, error)
+
+func (f PluginSecurityUserUpsert) WithContext(v context.Context) func(*PluginSecurityUserUpsertRequest) {
+	return func(r *PluginSecurityUserUpsertRequest) {
+		r.ctx = v
+	}
+}
+
+func (f PluginSecurityUserUpsert) WithName(v string) func(request *PluginSecurityUserUpsertRequest) {
+	return func(r *PluginSecurityUserUpsertRequest) {
+		r.Name = v
+	}
+}
+
+func (f PluginSecurityUserUpsert) WithBody(v io.Reader) func(*PluginSecurityUserUpsertRequest) {
+	return func(r *PluginSecurityUserUpsertRequest) {
+		r.Body = v
+	}
+}
+
+func (f PluginSecurityUserUpsert) WithHeader(n map[string]string) func(*PluginSecurityUserUpsertRequest) {
+	return func(r *PluginSecurityUserUpsertRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header, 0)
+		}
+		for k, v := range n {
+			r.Header.Add(k, v)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	redactedValue = "<redacted>"
+
+	// redactedBodyFormat replaces bodies that could not be parsed, and so could not be redacted
+	redactedBodyFormat = "<redacted %d byte body that is not JSON>"
+)
+
+// sensitiveKeyMarkers are substrings of JSON keys, header names and JSON Patch pointer segments whose values are always
+// redacted.  These include the JWT signing_key and SAML exchange_key of security plugin authentication domains.
+var sensitiveKeyMarkers = []string{
+	"password",
+	"hash",
+	"secret",
+	"token",
+	"credentials",
+	"authorization",
+	"cookie",
+	"signing_key",
+	"exchange_key",
+	"private_key",
+}
+
+func isSensitiveKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, m := range sensitiveKeyMarkers {
+		if strings.Contains(k, m) {
+			return true
+		}
+	}
+	return false
+}
+
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// Redactor masks sensitive values in logged requests and responses
+type Redactor struct {
+	paths [][]string
+}
+
+// NewRedactor creates a Redactor masking the values of sensitive JSON keys, as well as any value found at the
+// provided paths.  Paths are dot-separated object keys, where "*" matches any key.  Arrays are traversed without
+// consuming a path segment, so "users.*.hash" matches {"users": {"a": [{"hash": ""}]}} as well as
+// {"users": {"a": {"hash": ""}}}.
+func NewRedactor(paths []string) Redactor {
+	r := Redactor{}
+	for _, p := range paths {
+		if p = strings.TrimSpace(p); p != "" {
+			r.paths = append(r.paths, strings.Split(p, "."))
+		}
+	}
+	return r
+}
+
+// Body returns a copy of a JSON or newline-delimited JSON body with sensitive values masked.  Bodies that cannot be
+// parsed as either are replaced with a placeholder, as they may contain sensitive values that cannot be located.
+func (r Redactor) Body(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	if out, ok := r.document(body); ok {
+		return out
+	}
+
+	// newline-delimited json, as sent to the bulk and multi-search APIs, is redacted line by line
+	lines := bytes.Split(body, []byte("\n"))
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		out, ok := r.document(line)
+		if !ok {
+			return []byte(fmt.Sprintf(redactedBodyFormat, len(body)))
+		}
+		lines[i] = out
+	}
+	return bytes.Join(lines, []byte("\n"))
+}
+
+// document returns a copy of a single JSON document with sensitive values masked, or false if b is not exactly one
+// JSON document
+func (r Redactor) document(b []byte) ([]byte, bool) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return nil, false
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(r.value(v, r.paths)); err != nil {
+		return nil, false
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), true
+}
+
+// value masks sensitive keys within v, along with the values at any of the remaining path suffixes
+func (r Redactor) value(v interface{}, paths [][]string) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		if ptr, ok := patchOperationPath(tv); ok {
+			tv["value"] = r.patchValue(ptr, tv["value"])
+			return tv
+		}
+		for k, child := range tv {
+			if isSensitiveKey(k) {
+				tv[k] = redactedValue
+				continue
+			}
+			var next [][]string
+			masked := false
+			for _, p := range paths {
+				if p[0] != "*" && p[0] != k {
+					continue
+				}
+				if len(p) == 1 {
+					masked = true
+					break
+				}
+				next = append(next, p[1:])
+			}
+			if masked {
+				tv[k] = redactedValue
+			} else {
+				tv[k] = r.value(child, next)
+			}
+		}
+		return tv
+
+	case []interface{}:
+		for i, child := range tv {
+			tv[i] = r.value(child, paths)
+		}
+		return tv
+
+	default:
+		return v
+	}
+}
+
+// patchOperationPath returns the target of an RFC 6902 JSON Patch operation carrying a value, such as those sent to
+// the securityconfig API
+func patchOperationPath(m map[string]interface{}) (string, bool) {
+	if _, ok := m["value"]; !ok {
+		return "", false
+	}
+	if _, ok := m["op"].(string); !ok {
+		return "", false
+	}
+	ptr, ok := m["path"].(string)
+	return ptr, ok
+}
+
+// patchValue masks the value of a JSON Patch operation as though it were found at the JSON Pointer ptr, so that
+// sensitive keys and configured paths are matched against the pointer before the value itself.  Numeric pointer
+// segments are array indices, and so do not consume a path segment.
+func (r Redactor) patchValue(ptr string, v interface{}) interface{} {
+	paths := r.paths
+	for _, seg := range strings.Split(strings.TrimPrefix(ptr, "/"), "/") {
+		seg = jsonPointerUnescaper.Replace(seg)
+		if isSensitiveKey(seg) {
+			return redactedValue
+		}
+		if _, err := strconv.Atoi(seg); err == nil || seg == "-" {
+			continue
+		}
+		var next [][]string
+		for _, p := range paths {
+			if p[0] != "*" && p[0] != seg {
+				continue
+			}
+			if len(p) == 1 {
+				return redactedValue
+			}
+			next = append(next, p[1:])
+		}
+		paths = next
+	}
+	return r.value(v, paths)
+}
+
+// Header returns a copy of h with the values of sensitive headers, such as Authorization, masked
+func (r Redactor) Header(h http.Header) http.Header {
+	out := make(http.Header, len(h))
+	for k, v := range h {
+		if isSensitiveKey(k) {
+			out[k] = []string{redactedValue}
+		} else {
+			out[k] = append([]string(nil), v...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUnit_Redactor(t *testing.T) {
+	r := NewRedactor([]string{"config.dynamic.authc.*.http_authenticator.config.signing_key", "attributes.ssn", " "})
+
+	for name, tc := range map[string]struct {
+		in       string
+		expected string
+	}{
+		"sensitive-keys": {
+			in:       `{"password":"hunter2","hash":"$2y$12$abc","backend_roles":["admin"],"attributes":{"client_secret":"s","ssn":"123","team":"a"}}`,
+			expected: `{"attributes":{"client_secret":"<redacted>","ssn":"<redacted>","team":"a"},"backend_roles":["admin"],"hash":"<redacted>","password":"<redacted>"}`,
+		},
+		"nested-array": {
+			in:       `[{"op":"add","path":"/test","value":{"password":"hunter2"}}]`,
+			expected: `[{"op":"add","path":"/test","value":{"password":"<redacted>"}}]`,
+		},
+		"wildcard-path": {
+			in:       `{"config":{"dynamic":{"authc":{"jwt_auth_domain":{"order":1,"http_authenticator":{"config":{"signing_key":"abc","jwt_header":"Authorization"}}}}}}}`,
+			expected: `{"config":{"dynamic":{"authc":{"jwt_auth_domain":{"http_authenticator":{"config":{"jwt_header":"Authorization","signing_key":"<redacted>"}},"order":1}}}}}`,
+		},
+		"patch-scalar-value": {
+			in:       `[{"op":"add","path":"/config/dynamic/authc/jwt_auth_domain/http_authenticator/config/signing_key","value":"abc"},{"op":"add","path":"/attributes/ssn","value":"123"},{"op":"add","path":"/backend_roles/0","value":"admin"}]`,
+			expected: `[{"op":"add","path":"/config/dynamic/authc/jwt_auth_domain/http_authenticator/config/signing_key","value":"<redacted>"},{"op":"add","path":"/attributes/ssn","value":"<redacted>"},{"op":"add","path":"/backend_roles/0","value":"admin"}]`,
+		},
+		"patch-path-prefix": {
+			in:       `[{"op":"replace","path":"/attributes","value":{"ssn":"123","team":"a"}}]`,
+			expected: `[{"op":"replace","path":"/attributes","value":{"ssn":"<redacted>","team":"a"}}]`,
+		},
+		"numbers-preserved": {
+			in:       `{"count":12345678901234567890}`,
+			expected: `{"count":12345678901234567890}`,
+		},
+		"ndjson": {
+			in:       "{\"index\":{\"_index\":\"users\"}}\n{\"name\":\"a\",\"password\":\"hunter2\"}\n",
+			expected: "{\"index\":{\"_index\":\"users\"}}\n{\"name\":\"a\",\"password\":\"<redacted>\"}\n",
+		},
+		"concatenated-json": {
+			in:       `{"name":"a"} {"token":"abc"}`,
+			expected: "<redacted 28 byte body that is not JSON>",
+		},
+		"partially-json": {
+			in:       "{\"password\":\"<redacted>\"}\npassword=hunter2",
+			expected: "<redacted 42 byte body that is not JSON>",
+		},
+		"not-json": {
+			in:       `<html>Bad Gateway</html>`,
+			expected: "<redacted 24 byte body that is not JSON>",
+		},
+	} {
+		if actual := string(r.Body([]byte(tc.in))); actual != tc.expected {
+			t.Errorf("%s: expected:\n%s\nsaw:\n%s", name, tc.expected, actual)
+		}
+	}
+
+	h := make(http.Header)
+	h.Set("Authorization", "Basic YWRtaW46YWRtaW4=")
+	h.Set("X-Amz-Security-Token", "token")
+	h.Set("Content-Type", "application/json")
+	out := r.Header(h)
+	if out.Get("Authorization") != redactedValue || out.Get("X-Amz-Security-Token") != redactedValue {
+		t.Errorf("expected sensitive headers to be redacted, saw %v", out)
+	}
+	if out.Get("Content-Type") != "application/json" {
+		t.Errorf("expected Content-Type to be kept, saw %q", out.Get("Content-Type"))
+	}
+	if h.Get("Authorization") == redactedValue {
+		t.Error("expected original header to be unmodified")
+	}
+}
+
+func TestUnit_Redactor_SecurityConfigPatch(t *testing.T) {
+	// the body sent by opensearch_security_plugin_config when adding jwt and saml domains
+	ops := []PluginSecurityConfigPatchOperation{
+		{
+			Op:   "add",
+			Path: PluginSecurityConfigDynamicPath("authc", "jwt_auth_domain"),
+			Value: PluginSecurityConfigAuthcDomain{
+				HTTPEnabled: true,
+				Order:       1,
+				HTTPAuthenticator: PluginSecurityConfigHTTPAuthenticator{
+					Type:   "jwt",
+					Config: map[string]interface{}{"signing_key": "jwt-signing-secret", "jwt_header": "Authorization"},
+				},
+				AuthenticationBackend: PluginSecurityConfigBackend{Type: "noop"},
+			},
+		},
+		{
+			Op:   "add",
+			Path: PluginSecurityConfigDynamicPath("authc", "saml_auth_domain"),
+			Value: PluginSecurityConfigAuthcDomain{
+				HTTPEnabled: true,
+				Order:       2,
+				HTTPAuthenticator: PluginSecurityConfigHTTPAuthenticator{
+					Type:   "saml",
+					Config: map[string]interface{}{"exchange_key": "saml-exchange-secret", "idp": map[string]interface{}{"entity_id": "idp"}},
+				},
+				AuthenticationBackend: PluginSecurityConfigBackend{Type: "noop"},
+			},
+		},
+		{
+			Op:   "remove",
+			Path: PluginSecurityConfigDynamicPath("authz", "roles_from_myldap"),
+		},
+	}
+	body, err := json.Marshal(ops)
+	if err != nil {
+		t.Fatalf("error marshalling patch: %v", err)
+	}
+
+	out := string(NewRedactor(nil).Body(body))
+	for _, secret := range []string{"jwt-signing-secret", "saml-exchange-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("expected %q to be redacted, saw:\n%s", secret, out)
+		}
+	}
+	for _, kept := range []string{`"jwt_header":"Authorization"`, `"entity_id":"idp"`, `"op":"remove"`} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("expected %s to be kept, saw:\n%s", kept, out)
+		}
+	}
+}
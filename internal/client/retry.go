@@ -0,0 +1,276 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultRetryMaxRetries = 3
+	DefaultRetryMinBackoff = 250 * time.Millisecond
+	DefaultRetryMaxBackoff = 5 * time.Second
+
+	// retryPeekLimit is the maximum number of bytes of an error response body inspected when classifying a response
+	retryPeekLimit = 64 * 1024
+)
+
+// retryableErrorMarkers are lowercase substrings of OpenSearch error bodies indicating a transient condition that
+// rejected the request before it was applied, regardless of the status code of the response
+var retryableErrorMarkers = []string{
+	"cluster_block_exception",
+	"opensearch security not initialized",
+}
+
+// RetryPolicy describes which requests are retried, and how long to wait between attempts
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a single request is retried.  Zero disables retries.
+	MaxRetries int
+	// MinBackoff is the base delay used when computing exponential backoff.  Defaults to DefaultRetryMinBackoff.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper bound of any computed backoff delay.  Defaults to DefaultRetryMaxBackoff.
+	MaxBackoff time.Duration
+	// RetryOnStatus is a list of additional response status codes that are always retried
+	RetryOnStatus []int
+	// RetryOnTimeout enables retrying requests that failed with a network timeout
+	RetryOnTimeout bool
+}
+
+// RetryTransport is an http.RoundTripper that retries requests failing with transient network errors or transient
+// OpenSearch errors, waiting between each attempt using exponential backoff with jitter.  A Retry-After header sent
+// by OpenSearch takes precedence over the computed backoff.  Network errors and responses that do not reveal whether
+// a request was applied, such as a 409 or a gateway timeout, are only retried for GET and HEAD requests, as
+// repeating a create or a JSON Patch that was applied would fail.
+type RetryTransport struct {
+	transport http.RoundTripper
+	policy    RetryPolicy
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func NewRetryTransport(transport http.RoundTripper, policy RetryPolicy) *RetryTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if policy.MaxRetries < 0 {
+		policy.MaxRetries = 0
+	}
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = DefaultRetryMinBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DefaultRetryMaxBackoff
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = policy.MinBackoff
+	}
+	rt := RetryTransport{
+		transport: transport,
+		policy:    policy,
+		rnd:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	return &rt
+}
+
+// Unwrap returns the underlying transport
+func (rt *RetryTransport) Unwrap() http.RoundTripper {
+	return rt.transport
+}
+
+func (rt *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		resp *http.Response
+		err  error
+
+		ctx     = req.Context()
+		getBody = req.GetBody
+	)
+
+	// buffer the request body so that it may be sent again
+	if req.Body != nil && req.Body != http.NoBody && getBody == nil {
+		var b []byte
+		b, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(b)), nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if getBody != nil && (attempt > 0 || req.GetBody == nil) {
+			attemptReq = req.Clone(ctx)
+			if attemptReq.Body, err = getBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = rt.transport.RoundTrip(attemptReq)
+
+		if attempt >= rt.policy.MaxRetries || !rt.shouldRetry(ctx, req, resp, err) {
+			return resp, err
+		}
+
+		wait := rt.backoff(attempt, resp)
+
+		// do not bother waiting if the request deadline would pass before the next attempt is made
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+
+		logFields := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			logFields["err"] = err.Error()
+		} else {
+			logFields["response_code"] = resp.StatusCode
+		}
+		tflog.Debug(ctx, "Retrying OpenSearch request", logFields)
+
+		// drain and close body of the response being discarded, allowing the connection to be reused
+		if resp != nil && resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry determines whether the outcome of a single attempt warrants another attempt
+func (rt *RetryTransport) shouldRetry(ctx context.Context, req *http.Request, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+
+	if err != nil {
+		if !idempotent {
+			return false
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return true
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) {
+			return !netErr.Timeout() || rt.policy.RetryOnTimeout
+		}
+		return false
+	}
+
+	switch {
+	case codeMatch(resp.StatusCode, rt.policy.RetryOnStatus):
+		return true
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case resp.StatusCode == http.StatusConflict,
+		resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusGatewayTimeout:
+		return idempotent
+	case resp.StatusCode >= http.StatusBadRequest:
+		return retryableErrorBody(resp)
+	}
+
+	return false
+}
+
+// retryableErrorBody inspects the start of an error response body for markers of a transient error.  The body is
+// restored so that it may still be read by the caller.
+func retryableErrorBody(resp *http.Response) bool {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return false
+	}
+
+	peek, err := io.ReadAll(io.LimitReader(resp.Body, retryPeekLimit))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.MultiReader(bytes.NewReader(peek), resp.Body),
+		Closer: resp.Body,
+	}
+	if err != nil {
+		return false
+	}
+
+	body := strings.ToLower(string(peek))
+	for _, marker := range retryableErrorMarkers {
+		if strings.Contains(body, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the duration to wait before the next attempt, preferring a Retry-After header sent by OpenSearch
+func (rt *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return d
+		}
+	}
+
+	// exponential backoff, capped at the configured maximum
+	d := rt.policy.MaxBackoff
+	if attempt < 32 {
+		if exp := rt.policy.MinBackoff << uint(attempt); exp > 0 && exp < d {
+			d = exp
+		}
+	}
+
+	// wait at least half of the computed backoff, plus a random jitter of up to the other half
+	half := d / 2
+	rt.mu.Lock()
+	jitter := time.Duration(rt.rnd.Int63n(int64(d-half) + 1))
+	rt.mu.Unlock()
+
+	return half + jitter
+}
+
+// retryAfter parses the value of a Retry-After header, which may be either a number of seconds or an HTTP date
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,290 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type retryTestResponse struct {
+	code       int
+	body       string
+	retryAfter string
+}
+
+// newRetryTestServer returns a server that writes each of the provided responses in order, repeating the final
+// response once exhausted, along with a counter of the requests it received
+func newRetryTestServer(t *testing.T, responses ...retryTestResponse) (*httptest.Server, *int32) {
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&count, 1)) - 1
+		if n >= len(responses) {
+			n = len(responses) - 1
+		}
+		// echo the request body, allowing tests to verify it was re-sent on each attempt
+		reqBody, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Request-Body", string(reqBody))
+		if responses[n].retryAfter != "" {
+			w.Header().Set("Retry-After", responses[n].retryAfter)
+		}
+		w.WriteHeader(responses[n].code)
+		_, _ = w.Write([]byte(responses[n].body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &count
+}
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	}
+}
+
+func doRetryTestRequest(t *testing.T, rt http.RoundTripper, method, url, body string) *http.Response {
+	var reqBody io.Reader
+	if body != "" {
+		// wrap reader to hide its type from http.NewRequest, ensuring GetBody is not populated
+		reqBody = io.MultiReader(strings.NewReader(body))
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, url, reqBody)
+	if err != nil {
+		t.Fatalf("error creating request: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestUnit_RetryTransport(t *testing.T) {
+	const (
+		securityNotInitialized = `{"error":{"type":"security_exception","reason":"OpenSearch Security not initialized."},"status":503}`
+		clusterBlock           = `{"error":{"type":"cluster_block_exception","reason":"blocked by: [FORBIDDEN/12/index read-only / allow delete (api)];"},"status":403}`
+		badRequest             = `{"error":{"type":"illegal_argument_exception","reason":"nope"},"status":400}`
+	)
+
+	t.Run("retries-transient-errors", func(t *testing.T) {
+		srv, count := newRetryTestServer(
+			t,
+			retryTestResponse{code: http.StatusServiceUnavailable, body: securityNotInitialized},
+			retryTestResponse{code: http.StatusTooManyRequests},
+			retryTestResponse{code: http.StatusForbidden, body: clusterBlock},
+			retryTestResponse{code: http.StatusOK, body: `{}`},
+		)
+		resp := doRetryTestRequest(t, NewRetryTransport(nil, testRetryPolicy()), http.MethodPut, srv.URL, `{"key":"value"}`)
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status %d, saw %d", http.StatusOK, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 4 {
+			t.Errorf("expected 4 requests, saw %d", n)
+		}
+		if b := resp.Header.Get("X-Request-Body"); b != `{"key":"value"}` {
+			t.Errorf("expected request body to be re-sent, saw %q", b)
+		}
+	})
+
+	t.Run("retries-idempotent-conflict", func(t *testing.T) {
+		for _, method := range []string{http.MethodGet, http.MethodHead} {
+			srv, count := newRetryTestServer(
+				t,
+				retryTestResponse{code: http.StatusConflict, body: `{"error":{"type":"version_conflict_engine_exception"},"status":409}`},
+				retryTestResponse{code: http.StatusOK, body: `{}`},
+			)
+			resp := doRetryTestRequest(t, NewRetryTransport(nil, testRetryPolicy()), method, srv.URL, "")
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("%s: expected status %d, saw %d", method, http.StatusOK, resp.StatusCode)
+			}
+			if n := atomic.LoadInt32(count); n != 2 {
+				t.Errorf("%s: expected 2 requests, saw %d", method, n)
+			}
+		}
+	})
+
+	t.Run("does-not-retry-ambiguous-writes", func(t *testing.T) {
+		for _, code := range []int{http.StatusConflict, http.StatusBadGateway, http.StatusGatewayTimeout} {
+			for _, method := range []string{http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete} {
+				srv, count := newRetryTestServer(t, retryTestResponse{code: code}, retryTestResponse{code: http.StatusOK})
+				resp := doRetryTestRequest(t, NewRetryTransport(nil, testRetryPolicy()), method, srv.URL, `[{"op":"remove","path":"/config/dynamic/authz/a"}]`)
+				if resp.StatusCode != code {
+					t.Errorf("%s %d: expected status %d, saw %d", method, code, code, resp.StatusCode)
+				}
+				if n := atomic.LoadInt32(count); n != 1 {
+					t.Errorf("%s %d: expected 1 request, saw %d", method, code, n)
+				}
+			}
+		}
+	})
+
+	t.Run("retries-network-errors-only-when-idempotent", func(t *testing.T) {
+		for method, expected := range map[string]int32{http.MethodGet: 2, http.MethodPut: 1} {
+			var count int32
+			transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				if atomic.AddInt32(&count, 1) == 1 {
+					return nil, io.ErrUnexpectedEOF
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+			})
+			req, _ := http.NewRequestWithContext(context.Background(), method, "https://localhost:9200/", nil)
+			resp, err := NewRetryTransport(transport, testRetryPolicy()).RoundTrip(req)
+			if expected == 1 && err == nil {
+				t.Errorf("%s: expected error", method)
+			} else if expected == 2 && (err != nil || resp.StatusCode != http.StatusOK) {
+				t.Errorf("%s: expected success after retry, saw %v", method, err)
+			}
+			if n := atomic.LoadInt32(&count); n != expected {
+				t.Errorf("%s: expected %d requests, saw %d", method, expected, n)
+			}
+		}
+	})
+
+	t.Run("does-not-match-partial-not-initialized", func(t *testing.T) {
+		body := `{"error":{"type":"illegal_state_exception","reason":"index template not initialized"},"status":400}`
+		srv, count := newRetryTestServer(t, retryTestResponse{code: http.StatusBadRequest, body: body})
+		resp := doRetryTestRequest(t, NewRetryTransport(nil, testRetryPolicy()), http.MethodGet, srv.URL, "")
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected status %d, saw %d", http.StatusBadRequest, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 1 {
+			t.Errorf("expected 1 request, saw %d", n)
+		}
+	})
+
+	t.Run("does-not-retry-client-errors", func(t *testing.T) {
+		srv, count := newRetryTestServer(t, retryTestResponse{code: http.StatusBadRequest, body: badRequest})
+		resp := doRetryTestRequest(t, NewRetryTransport(nil, testRetryPolicy()), http.MethodPut, srv.URL, "")
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected status %d, saw %d", http.StatusBadRequest, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 1 {
+			t.Errorf("expected 1 request, saw %d", n)
+		}
+		// body must remain readable after being inspected
+		if b, _ := io.ReadAll(resp.Body); string(b) != badRequest {
+			t.Errorf("expected body %q, saw %q", badRequest, string(b))
+		}
+	})
+
+	t.Run("retry-on-status", func(t *testing.T) {
+		srv, count := newRetryTestServer(
+			t,
+			retryTestResponse{code: http.StatusInternalServerError},
+			retryTestResponse{code: http.StatusOK},
+		)
+		policy := testRetryPolicy()
+		policy.RetryOnStatus = []int{http.StatusInternalServerError}
+		resp := doRetryTestRequest(t, NewRetryTransport(nil, policy), http.MethodPut, srv.URL, "")
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status %d, saw %d", http.StatusOK, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 2 {
+			t.Errorf("expected 2 requests, saw %d", n)
+		}
+	})
+
+	t.Run("max-retries", func(t *testing.T) {
+		srv, count := newRetryTestServer(t, retryTestResponse{code: http.StatusServiceUnavailable, body: securityNotInitialized})
+		resp := doRetryTestRequest(t, NewRetryTransport(nil, testRetryPolicy()), http.MethodPut, srv.URL, "")
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("expected status %d, saw %d", http.StatusServiceUnavailable, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 4 {
+			t.Errorf("expected 4 requests, saw %d", n)
+		}
+		if b, _ := io.ReadAll(resp.Body); string(b) != securityNotInitialized {
+			t.Errorf("expected final response body %q, saw %q", securityNotInitialized, string(b))
+		}
+	})
+
+	t.Run("zero-max-retries", func(t *testing.T) {
+		srv, count := newRetryTestServer(t, retryTestResponse{code: http.StatusServiceUnavailable, body: securityNotInitialized})
+		policy := testRetryPolicy()
+		policy.MaxRetries = 0
+		resp := doRetryTestRequest(t, NewRetryTransport(nil, policy), http.MethodPut, srv.URL, "")
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("expected status %d, saw %d", http.StatusServiceUnavailable, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 1 {
+			t.Errorf("expected 1 request, saw %d", n)
+		}
+	})
+
+	t.Run("honors-retry-after", func(t *testing.T) {
+		srv, count := newRetryTestServer(
+			t,
+			retryTestResponse{code: http.StatusTooManyRequests, retryAfter: "1"},
+			retryTestResponse{code: http.StatusOK},
+		)
+		start := time.Now()
+		resp := doRetryTestRequest(t, NewRetryTransport(nil, testRetryPolicy()), http.MethodPut, srv.URL, "")
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status %d, saw %d", http.StatusOK, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 2 {
+			t.Errorf("expected 2 requests, saw %d", n)
+		}
+		if waited := time.Since(start); waited < time.Second {
+			t.Errorf("expected to wait at least 1s, waited %s", waited)
+		}
+	})
+
+	t.Run("stops-at-deadline", func(t *testing.T) {
+		srv, count := newRetryTestServer(t, retryTestResponse{code: http.StatusTooManyRequests, retryAfter: "60"})
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		resp, err := NewRetryTransport(nil, testRetryPolicy()).RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("expected status %d, saw %d", http.StatusTooManyRequests, resp.StatusCode)
+		}
+		if n := atomic.LoadInt32(count); n != 1 {
+			t.Errorf("expected 1 request, saw %d", n)
+		}
+	})
+}
+
+func TestUnit_RetryBackoff(t *testing.T) {
+	rt := NewRetryTransport(nil, RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second})
+	for attempt, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		want *= time.Millisecond
+		for i := 0; i < 20; i++ {
+			if d := rt.backoff(attempt, nil); d < want/2 || d > want {
+				t.Errorf("attempt %d: expected backoff within [%s, %s], saw %s", attempt, want/2, want, d)
+			}
+		}
+	}
+}
+
+func TestUnit_RetryAfter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"garbage", 0, false},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second, true},
+		{now.Add(-30 * time.Second).Format(http.TimeFormat), 0, true},
+	}
+	for _, tt := range tests {
+		d, ok := retryAfter(tt.in, now)
+		if d != tt.want || ok != tt.ok {
+			t.Errorf("retryAfter(%q): expected (%s, %t), saw (%s, %t)", tt.in, tt.want, tt.ok, d, ok)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DistributionOpenSearch    = "opensearch"
+	DistributionElasticsearch = "elasticsearch"
+)
+
+// Version is the major, minor and patch number of an OpenSearch release.  The zero value represents an unknown
+// version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseVersion parses a version such as "2.11.0".  Missing minor and patch numbers are treated as 0, and any
+// pre-release or build suffix such as "-SNAPSHOT" is ignored.
+func ParseVersion(s string) (Version, error) {
+	var (
+		v     Version
+		parts []string
+	)
+
+	trimmed := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(trimmed, "-+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	if parts = strings.Split(trimmed, "."); len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q: expected at most 3 parts", s)
+	}
+
+	dst := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q: %q is not a non-negative integer", s, p)
+		}
+		*dst[i] = n
+	}
+
+	return v, nil
+}
+
+// IsZero returns true if the version is unknown
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Compare returns -1, 0 or 1 if v is older than, the same as, or newer than other
+func (v Version) Compare(other Version) int {
+	for _, d := range [][2]int{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if d[0] < d[1] {
+			return -1
+		} else if d[0] > d[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// LessThan returns true if v is older than other
+func (v Version) LessThan(other Version) bool {
+	return v.Compare(other) < 0
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// ClusterInfo is the response to the root "/" endpoint
+type ClusterInfo struct {
+	Name        string             `json:"name"`
+	ClusterName string             `json:"cluster_name"`
+	ClusterUUID string             `json:"cluster_uuid"`
+	Version     ClusterInfoVersion `json:"version"`
+	Tagline     string             `json:"tagline"`
+}
+
+type ClusterInfoVersion struct {
+	Number       string `json:"number"`
+	Distribution string `json:"distribution"`
+	BuildFlavor  string `json:"build_flavor"`
+}
+
+// Distribution returns the distribution reported by the cluster.  Only OpenSearch reports a distribution, so
+// Elasticsearch is assumed when it is missing.
+func (i ClusterInfo) Distribution() string {
+	if i.Version.Distribution == "" {
+		return DistributionElasticsearch
+	}
+	return i.Version.Distribution
+}
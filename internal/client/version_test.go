@@ -0,0 +1,57 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnit_ParseVersion(t *testing.T) {
+	for in, expected := range map[string]Version{
+		"2.11.0":          {Major: 2, Minor: 11},
+		"1.3.14":          {Major: 1, Minor: 3, Patch: 14},
+		"2.5":             {Major: 2, Minor: 5},
+		"3":               {Major: 3},
+		"v2.4.1":          {Major: 2, Minor: 4, Patch: 1},
+		"3.0.0-alpha1":    {Major: 3},
+		"2.12.0-SNAPSHOT": {Major: 2, Minor: 12},
+	} {
+		actual, err := ParseVersion(in)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", in, err)
+		} else if actual != expected {
+			t.Errorf("%q: expected %s, saw %s", in, expected, actual)
+		}
+	}
+
+	for _, in := range []string{"", "two", "2.x", "1.2.3.4", "-1.0.0"} {
+		if _, err := ParseVersion(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestUnit_VersionCompare(t *testing.T) {
+	older, _ := ParseVersion("2.4.1")
+	newer, _ := ParseVersion("2.11.0")
+	if !older.LessThan(newer) || newer.LessThan(older) || older.LessThan(older) {
+		t.Errorf("unexpected ordering of %s and %s", older, newer)
+	}
+	if !(Version{}).IsZero() || older.IsZero() {
+		t.Error("unexpected IsZero result")
+	}
+}
+
+func TestUnit_ClusterInfoDistribution(t *testing.T) {
+	for body, expected := range map[string]string{
+		`{"version":{"distribution":"opensearch","number":"2.11.0"},"tagline":"The OpenSearch Project: https://opensearch.org/"}`: DistributionOpenSearch,
+		`{"version":{"number":"8.11.1","build_flavor":"default"},"tagline":"You Know, for Search"}`:                               DistributionElasticsearch,
+	} {
+		var info ClusterInfo
+		if err := json.Unmarshal([]byte(body), &info); err != nil {
+			t.Fatalf("error decoding %s: %v", body, err)
+		}
+		if actual := info.Distribution(); actual != expected {
+			t.Errorf("expected distribution %q, saw %q", expected, actual)
+		}
+	}
+}
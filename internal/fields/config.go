@@ -1 +1,53 @@
 package fields
+
+// Environment variables consulted for provider attributes not set in configuration
+const (
+	EnvAddresses             = "OPENSEARCH_URL"
+	EnvUsername              = "OPENSEARCH_USERNAME"
+	EnvPassword              = "OPENSEARCH_PASSWORD"
+	EnvToken                 = "OPENSEARCH_TOKEN"
+	EnvAuthHeader            = "OPENSEARCH_AUTH_HEADER"
+	EnvCACert                = "OPENSEARCH_CA_CERT"
+	EnvClientCert            = "OPENSEARCH_CLIENT_CERT"
+	EnvClientKey             = "OPENSEARCH_CLIENT_KEY"
+	EnvClientKeyPassword     = "OPENSEARCH_CLIENT_KEY_PASSWORD"
+	EnvProxyURL              = "OPENSEARCH_PROXY_URL"
+	EnvNoProxy               = "OPENSEARCH_NO_PROXY"
+	EnvRetryOnStatus         = "OPENSEARCH_RETRY_ON_STATUS"
+	EnvDisableRetry          = "OPENSEARCH_DISABLE_RETRY"
+	EnvEnableRetryOnTimeout  = "OPENSEARCH_ENABLE_RETRY_ON_TIMEOUT"
+	EnvMaxRetries            = "OPENSEARCH_MAX_RETRIES"
+	EnvRetryMinBackoff       = "OPENSEARCH_RETRY_MIN_BACKOFF"
+	EnvRetryMaxBackoff       = "OPENSEARCH_RETRY_MAX_BACKOFF"
+	EnvCompressRequestBody   = "OPENSEARCH_COMPRESS_REQUEST_BODY"
+	EnvInsecureSkipTLSVerify = "OPENSEARCH_INSECURE"
+	EnvEnableOnRequestCheck  = "OPENSEARCH_ENABLE_ON_REQUEST_CHECK"
+	EnvSkipInitProductCheck  = "OPENSEARCH_SKIP_INIT_PRODUCT_CHECK"
+	EnvDefaultTimeout        = "OPENSEARCH_DEFAULT_TIMEOUT"
+	EnvOpaqueIDPrefix        = "OPENSEARCH_OPAQUE_ID_PREFIX"
+	EnvMinimumVersion        = "OPENSEARCH_MINIMUM_VERSION"
+	EnvClientDebugLogger     = "OPENSEARCH_CLIENT_DEBUG_LOGGER"
+	EnvRequestTraceLogger    = "OPENSEARCH_REQUEST_TRACE_LOGGER"
+	EnvTLSCACertFile         = "OPENSEARCH_TLS_CA_CERT_FILE"
+	EnvTLSAppendSystemRoots  = "OPENSEARCH_TLS_APPEND_SYSTEM_ROOTS"
+	EnvTLSServerName         = "OPENSEARCH_TLS_SERVER_NAME"
+	EnvTLSMinVersion         = "OPENSEARCH_TLS_MIN_VERSION"
+	EnvTLSCipherSuites       = "OPENSEARCH_TLS_CIPHER_SUITES"
+	EnvTLSPinnedSPKISHA256   = "OPENSEARCH_TLS_PINNED_SPKI_SHA256"
+
+	EnvAWSRegion                = "AWS_REGION"
+	EnvAWSService               = "OPENSEARCH_AWS_SERVICE"
+	EnvAWSProfile               = "AWS_PROFILE"
+	EnvAWSAccessKey             = "AWS_ACCESS_KEY_ID"
+	EnvAWSSecretKey             = "AWS_SECRET_ACCESS_KEY"
+	EnvAWSSessionToken          = "AWS_SESSION_TOKEN"
+	EnvAWSAssumeRoleARN         = "OPENSEARCH_AWS_ASSUME_ROLE_ARN"
+	EnvAWSAssumeRoleSessionName = "OPENSEARCH_AWS_ASSUME_ROLE_SESSION_NAME"
+	EnvAWSSTSEndpoint           = "OPENSEARCH_AWS_STS_ENDPOINT"
+
+	EnvOIDCTokenURL     = "OPENSEARCH_OIDC_TOKEN_URL"
+	EnvOIDCClientID     = "OPENSEARCH_OIDC_CLIENT_ID"
+	EnvOIDCClientSecret = "OPENSEARCH_OIDC_CLIENT_SECRET"
+	EnvOIDCScopes       = "OPENSEARCH_OIDC_SCOPES"
+	EnvOIDCAudience     = "OPENSEARCH_OIDC_AUDIENCE"
+)
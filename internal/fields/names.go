@@ -11,10 +11,15 @@ const (
 	ConfigAttrUsername              = "username"
 	ConfigAttrPassword              = "password"
 	ConfigAttrCACert                = "ca_cert"
+	ConfigAttrClientCert            = "client_cert"
+	ConfigAttrClientKey             = "client_key"
+	ConfigAttrClientKeyPassword     = "client_key_password"
 	ConfigAttrRetryOnStatus         = "retry_on_status"
 	ConfigAttrDisableRetry          = "disable_retry"
 	ConfigAttrEnableRetryOnTimeout  = "enable_retry_on_timeout"
 	ConfigAttrMaxRetries            = "max_retries"
+	ConfigAttrRetryMinBackoff       = "retry_min_backoff"
+	ConfigAttrRetryMaxBackoff       = "retry_max_backoff"
 	ConfigAttrCompressRequestBody   = "compress_request_body"
 	ConfigAttrInsecureSkipTLSVerify = "insecure_skip_tls_verify"
 	ConfigAttrEnableOnRequestCheck  = "enable_on_request_check"
@@ -24,36 +29,178 @@ const (
 	ConfigAttrEnabled               = "enabled"
 	ConfigAttrIncludeRequestBody    = "include_request_body"
 	ConfigAttrIncludeResponseBody   = "include_response_body"
+	ConfigAttrRedactPaths           = "redact_paths"
+	ConfigAttrHARFile               = "har_file"
+	ConfigAttrDefaultTimeout        = "default_timeout"
+	ConfigAttrTLS                   = "tls"
+	ConfigAttrCACertFile            = "ca_cert_file"
+	ConfigAttrAppendSystemRoots     = "append_system_roots"
+	ConfigAttrServerName            = "server_name"
+	ConfigAttrMinVersion            = "min_version"
+	ConfigAttrCipherSuites          = "cipher_suites"
+	ConfigAttrPinnedSPKISHA256      = "pinned_spki_sha256"
+	ConfigAttrAWS                   = "aws"
+	ConfigAttrRegion                = "region"
+	ConfigAttrService               = "service"
+	ConfigAttrProfile               = "profile"
+	ConfigAttrAccessKey             = "access_key"
+	ConfigAttrSecretKey             = "secret_key"
+	ConfigAttrSessionToken          = "session_token"
+	ConfigAttrAssumeRoleARN         = "assume_role_arn"
+	ConfigAttrAssumeRoleSessionName = "assume_role_session_name"
+	ConfigAttrSTSEndpoint           = "sts_endpoint"
+	ConfigAttrToken                 = "token"
+	ConfigAttrHeaders               = "headers"
+	ConfigAttrAuthHeader            = "auth_header"
+	ConfigAttrOIDC                  = "oidc"
+	ConfigAttrTokenURL              = "token_url"
+	ConfigAttrClientID              = "client_id"
+	ConfigAttrClientSecret          = "client_secret"
+	ConfigAttrScopes                = "scopes"
+	ConfigAttrAudience              = "audience"
+	ConfigAttrCredentialProcess     = "credential_process"
+	ConfigAttrCommand               = "command"
+	ConfigAttrEnv                   = "env"
+	ConfigAttrTimeout               = "timeout"
+	ConfigAttrProxyURL              = "proxy_url"
+	ConfigAttrNoProxy               = "no_proxy"
+	ConfigAttrOpaqueIDPrefix        = "opaque_id_prefix"
+	ConfigAttrMinimumVersion        = "minimum_version"
 )
 
 const (
 	ResourceTypeSecurityPluginRole = "security_plugin_role"
 	ResourceTypeSecurityPluginUser = "security_plugin_user"
+
+	ResourceTypeSecurityPluginRoleMapping = "security_plugin_role_mapping"
+	ResourceTypeSecurityPluginActionGroup = "security_plugin_action_group"
+	ResourceTypeSecurityPluginTenant      = "security_plugin_tenant"
+	ResourceTypeSecurityPluginAuditConfig = "security_plugin_audit_config"
+	ResourceTypeSecurityPluginConfig      = "security_plugin_config"
+	ResourceTypeSecurityPluginNodesDN     = "security_plugin_nodes_dn"
+	ResourceTypeSecurityPluginAllowlist   = "security_plugin_allowlist"
+)
+
+const (
+	DataSourceTypeSecurityPluginRole  = "security_plugin_role"
+	DataSourceTypeSecurityPluginRoles = "security_plugin_roles"
 )
 
 const (
-	ResourceAttrAllowedActions          = "allowed_actions"
-	ResourceAttrAttributes              = "attributes"
-	ResourceAttrBackendRoles            = "backend_roles"
-	ResourceAttrClusterPermissions      = "cluster_permissions"
-	ResourceAttrDescription             = "description"
-	ResourceAttrDLS                     = "dls"
-	ResourceAttrFLS                     = "fls"
-	ResourceAttrHash                    = "hash"
-	ResourceAttrHidden                  = "hidden"
-	ResourceAttrID                      = "id"
-	ResourceAttrIndexPatterns           = "index_patterns"
-	ResourceAttrIndexPermissions        = "index_permissions"
-	ResourceAttrMaskedFields            = "masked_fields"
-	ResourceAttrOpenDistroSecurityRoles = "open_distro_security_roles"
-	ResourceAttrPassword                = "password"
-	ResourceAttrReserved                = "reserved"
-	ResourceAttrRoleName                = "role_name"
-	ResourceAttrRoles                   = "roles"
-	ResourceAttrStatic                  = "static"
-	ResourceAttrTenantPatterns          = "tenant_patterns"
-	ResourceAttrTenantPermissions       = "tenant_permissions"
-	ResourceAttrUsername                = "username"
+	ResourceAttrActionGroupName              = "action_group_name"
+	ResourceAttrAllowedActions               = "allowed_actions"
+	ResourceAttrAndBackendRoles              = "and_backend_roles"
+	ResourceAttrAnonymousAuthEnabled         = "anonymous_auth_enabled"
+	ResourceAttrAttributes                   = "attributes"
+	ResourceAttrAudit                        = "audit"
+	ResourceAttrAuthc                        = "authc"
+	ResourceAttrAuthenticationBackend        = "authentication_backend"
+	ResourceAttrAuthz                        = "authz"
+	ResourceAttrBackendRoles                 = "backend_roles"
+	ResourceAttrBasic                        = "basic"
+	ResourceAttrBindDN                       = "bind_dn"
+	ResourceAttrChallenge                    = "challenge"
+	ResourceAttrClusterPermissions           = "cluster_permissions"
+	ResourceAttrClusterName                  = "cluster_name"
+	ResourceAttrCompliance                   = "compliance"
+	ResourceAttrCreate                       = "create"
+	ResourceAttrDefaultTenant                = "default_tenant"
+	ResourceAttrDelete                       = "delete"
+	ResourceAttrDescription                  = "description"
+	ResourceAttrDisabledRestCategories       = "disabled_rest_categories"
+	ResourceAttrDisabledTransportCategories  = "disabled_transport_categories"
+	ResourceAttrDLS                          = "dls"
+	ResourceAttrDoNotFailOnForbidden         = "do_not_fail_on_forbidden"
+	ResourceAttrEnableRest                   = "enable_rest"
+	ResourceAttrEnableSSL                    = "enable_ssl"
+	ResourceAttrEnableStartTLS               = "enable_start_tls"
+	ResourceAttrEnableTransport              = "enable_transport"
+	ResourceAttrEnabled                      = "enabled"
+	ResourceAttrExchangeKey                  = "exchange_key"
+	ResourceAttrExcludeSensitiveHeaders      = "exclude_sensitive_headers"
+	ResourceAttrExternalConfig               = "external_config"
+	ResourceAttrFLS                          = "fls"
+	ResourceAttrHash                         = "hash"
+	ResourceAttrHidden                       = "hidden"
+	ResourceAttrHosts                        = "hosts"
+	ResourceAttrHTTP                         = "http"
+	ResourceAttrHTTPEnabled                  = "http_enabled"
+	ResourceAttrID                           = "id"
+	ResourceAttrIDPEntityID                  = "idp_entity_id"
+	ResourceAttrIDPMetadataURL               = "idp_metadata_url"
+	ResourceAttrIgnoreRequests               = "ignore_requests"
+	ResourceAttrIgnoreUsers                  = "ignore_users"
+	ResourceAttrIndex                        = "index"
+	ResourceAttrIndexPatterns                = "index_patterns"
+	ResourceAttrIndexPermissions             = "index_permissions"
+	ResourceAttrInternalConfig               = "internal_config"
+	ResourceAttrJWT                          = "jwt"
+	ResourceAttrJWTClockSkewToleranceSeconds = "jwt_clock_skew_tolerance_seconds"
+	ResourceAttrJWTHeader                    = "jwt_header"
+	ResourceAttrJWTURLParameter              = "jwt_url_parameter"
+	ResourceAttrKibana                       = "kibana"
+	ResourceAttrKibanaURL                    = "kibana_url"
+	ResourceAttrLDAP                         = "ldap"
+	ResourceAttrLogRequestBody               = "log_request_body"
+	ResourceAttrMaskedFields                 = "masked_fields"
+	ResourceAttrMultitenancyEnabled          = "multitenancy_enabled"
+	ResourceAttrName                         = "name"
+	ResourceAttrNameRegex                    = "name_regex"
+	ResourceAttrNames                        = "names"
+	ResourceAttrNodesDN                      = "nodes_dn"
+	ResourceAttrOpenDistroSecurityRoles      = "opendistro_security_roles"
+	ResourceAttrOpenID                       = "openid"
+	ResourceAttrOpenIDConnectURL             = "openid_connect_url"
+	ResourceAttrOrder                        = "order"
+	ResourceAttrPassword                     = "password"
+	ResourceAttrPEMTrustedCAsContent         = "pemtrustedcas_content"
+	ResourceAttrPrivateTenantEnabled         = "private_tenant_enabled"
+	ResourceAttrProxy                        = "proxy"
+	ResourceAttrRead                         = "read"
+	ResourceAttrReadIgnoreUsers              = "read_ignore_users"
+	ResourceAttrReadMetadataOnly             = "read_metadata_only"
+	ResourceAttrReadWatchedFields            = "read_watched_fields"
+	ResourceAttrRequests                     = "requests"
+	ResourceAttrReserved                     = "reserved"
+	ResourceAttrResolveBulkRequests          = "resolve_bulk_requests"
+	ResourceAttrResolveIndices               = "resolve_indices"
+	ResourceAttrResolveNestedRoles           = "resolve_nested_roles"
+	ResourceAttrRoleName                     = "role_name"
+	ResourceAttrRoleBase                     = "rolebase"
+	ResourceAttrLDAPRoleName                 = "rolename"
+	ResourceAttrRoles                        = "roles"
+	ResourceAttrRolesHeader                  = "roles_header"
+	ResourceAttrRolesKey                     = "roles_key"
+	ResourceAttrRoleSearch                   = "rolesearch"
+	ResourceAttrSAML                         = "saml"
+	ResourceAttrServerUsername               = "server_username"
+	ResourceAttrSigningKey                   = "signing_key"
+	ResourceAttrSPEntityID                   = "sp_entity_id"
+	ResourceAttrStatic                       = "static"
+	ResourceAttrSubjectKey                   = "subject_key"
+	ResourceAttrTenantName                   = "tenant_name"
+	ResourceAttrTenantPatterns               = "tenant_patterns"
+	ResourceAttrTenantPermissions            = "tenant_permissions"
+	ResourceAttrTimeouts                     = "timeouts"
+	ResourceAttrTransportEnabled             = "transport_enabled"
+	ResourceAttrType                         = "type"
+	ResourceAttrUpdate                       = "update"
+	ResourceAttrUserHeader                   = "user_header"
+	ResourceAttrUserBase                     = "userbase"
+	ResourceAttrUsername                     = "username"
+	ResourceAttrUsernameAttribute            = "username_attribute"
+	ResourceAttrUserRoleAttribute            = "userroleattribute"
+	ResourceAttrUserRoleName                 = "userrolename"
+	ResourceAttrUsers                        = "users"
+	ResourceAttrUserSearch                   = "usersearch"
+	ResourceAttrVerifyHostnames              = "verify_hostnames"
+	ResourceAttrWriteIgnoreUsers             = "write_ignore_users"
+	ResourceAttrWriteLogDiffs                = "write_log_diffs"
+	ResourceAttrWriteMetadataOnly            = "write_metadata_only"
+	ResourceAttrWriteWatchedIndices          = "write_watched_indices"
+	ResourceAttrXFFEnabled                   = "xff_enabled"
+	ResourceAttrXFFInternalProxies           = "xff_internal_proxies"
+	ResourceAttrXFFRemoteIPHeader            = "xff_remote_ip_header"
 )
 
 func TypeName(providerName, typeName string) string {
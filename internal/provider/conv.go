@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
 	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
 	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
@@ -26,6 +29,42 @@ var (
 		fields.ResourceAttrTenantPatterns: types.ListType{ElemType: types.StringType},
 		fields.ResourceAttrAllowedActions: types.ListType{ElemType: types.StringType},
 	}
+
+	roleAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrDescription:        types.StringType,
+		fields.ResourceAttrClusterPermissions: types.ListType{ElemType: types.StringType},
+		fields.ResourceAttrIndexPermissions:   types.ListType{ElemType: types.ObjectType{AttrTypes: indexPermissionAttrTypeMap}},
+		fields.ResourceAttrTenantPermissions:  types.ListType{ElemType: types.ObjectType{AttrTypes: tenantPermissionAttrTypeMap}},
+		fields.ResourceAttrReserved:           types.BoolType,
+		fields.ResourceAttrHidden:             types.BoolType,
+		fields.ResourceAttrStatic:             types.BoolType,
+	}
+
+	auditSettingsAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrEnableRest:                  types.BoolType,
+		fields.ResourceAttrDisabledRestCategories:      types.ListType{ElemType: types.StringType},
+		fields.ResourceAttrEnableTransport:             types.BoolType,
+		fields.ResourceAttrDisabledTransportCategories: types.ListType{ElemType: types.StringType},
+		fields.ResourceAttrResolveBulkRequests:         types.BoolType,
+		fields.ResourceAttrLogRequestBody:              types.BoolType,
+		fields.ResourceAttrResolveIndices:              types.BoolType,
+		fields.ResourceAttrExcludeSensitiveHeaders:     types.BoolType,
+		fields.ResourceAttrIgnoreUsers:                 types.ListType{ElemType: types.StringType},
+		fields.ResourceAttrIgnoreRequests:              types.ListType{ElemType: types.StringType},
+	}
+
+	complianceSettingsAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrEnabled:             types.BoolType,
+		fields.ResourceAttrInternalConfig:      types.BoolType,
+		fields.ResourceAttrExternalConfig:      types.BoolType,
+		fields.ResourceAttrReadMetadataOnly:    types.BoolType,
+		fields.ResourceAttrReadWatchedFields:   types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+		fields.ResourceAttrReadIgnoreUsers:     types.ListType{ElemType: types.StringType},
+		fields.ResourceAttrWriteMetadataOnly:   types.BoolType,
+		fields.ResourceAttrWriteLogDiffs:       types.BoolType,
+		fields.ResourceAttrWriteWatchedIndices: types.ListType{ElemType: types.StringType},
+		fields.ResourceAttrWriteIgnoreUsers:    types.ListType{ElemType: types.StringType},
+	}
 )
 
 func toNestedObjectList[T any](attrTypes attrTypeMap, in []T, nullOnEmpty bool, fn func(T) (types.Object, diag.Diagnostics)) (types.List, diag.Diagnostics) {
@@ -79,6 +118,30 @@ func tenantPermissionsToTerraformNestedList(tp []client.PluginSecurityRoleTenant
 	return toNestedObjectList(tenantPermissionAttrTypeMap, tp, nullOnEmpty, tenantPermissionToTerraformObject)
 }
 
+func roleToTerraformObject(r client.PluginSecurityRole) (types.Object, diag.Diagnostics) {
+	indexPermissions, diags := indexPermissionsToTerraformNestedList(r.IndexPermissions, false)
+	if diags.HasError() {
+		return types.ObjectNull(roleAttrTypeMap), diags
+	}
+	tenantPermissions, diags := tenantPermissionsToTerraformNestedList(r.TenantPermissions, false)
+	if diags.HasError() {
+		return types.ObjectNull(roleAttrTypeMap), diags
+	}
+
+	return types.ObjectValue(
+		roleAttrTypeMap,
+		map[string]attr.Value{
+			fields.ResourceAttrDescription:        types.StringValue(r.Description),
+			fields.ResourceAttrClusterPermissions: conv.StringsToStringList(r.ClusterPermissions, false),
+			fields.ResourceAttrIndexPermissions:   indexPermissions,
+			fields.ResourceAttrTenantPermissions:  tenantPermissions,
+			fields.ResourceAttrReserved:           conv.BoolPtrToBoolValue(r.Reserved),
+			fields.ResourceAttrHidden:             conv.BoolPtrToBoolValue(r.Hidden),
+			fields.ResourceAttrStatic:             conv.BoolPtrToBoolValue(r.Static),
+		},
+	)
+}
+
 func mapObjectToType[T any](obj types.Object, fn func(map[string]attr.Value) T) T {
 	return fn(obj.Attributes())
 }
@@ -143,6 +206,30 @@ func mapTerraformTenantPermissionsToTenantPermissionsType(attrs map[string]attr.
 	return out
 }
 
+func stringsToTerraformStringMap(in map[string]string, nullOnEmpty bool) types.Map {
+	if nullOnEmpty && len(in) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elems := make(map[string]attr.Value, len(in))
+	for k, v := range in {
+		elems[k] = types.StringValue(v)
+	}
+
+	return types.MapValueMust(types.StringType, elems)
+}
+
+func terraformStringMapToStrings(v types.Map) map[string]string {
+	elems := v.Elements()
+
+	out := make(map[string]string, len(elems))
+	for k, e := range elems {
+		out[k] = e.(types.String).ValueString()
+	}
+
+	return out
+}
+
 func terraformSecurityRoleToSecurityRole(d *PluginSecurityRoleResourceData) client.PluginSecurityRole {
 	osRole := client.PluginSecurityRole{
 		RoleName:    d.RoleName.ValueString(),
@@ -155,3 +242,671 @@ func terraformSecurityRoleToSecurityRole(d *PluginSecurityRoleResourceData) clie
 
 	return osRole
 }
+
+func terraformSecurityUserToSecurityUser(d *PluginSecurityUserResourceData) client.PluginSecurityUser {
+	osUser := client.PluginSecurityUser{
+		Username: d.Username.ValueString(),
+		Password: d.Password.ValueString(),
+		Hash:     d.Hash.ValueString(),
+
+		BackendRoles:            conv.StringListToStrings(d.BackendRoles),
+		OpenDistroSecurityRoles: conv.StringListToStrings(d.OpenDistroSecurityRoles),
+		Attributes:              terraformStringMapToStrings(d.Attributes),
+	}
+
+	return osUser
+}
+
+func terraformSecurityRoleMappingToSecurityRoleMapping(d *PluginSecurityRoleMappingResourceData) client.PluginSecurityRoleMapping {
+	osMapping := client.PluginSecurityRoleMapping{
+		RoleName:    d.RoleName.ValueString(),
+		Description: d.Description.ValueString(),
+
+		BackendRoles:    conv.StringListToStrings(d.BackendRoles),
+		AndBackendRoles: conv.StringListToStrings(d.AndBackendRoles),
+		Hosts:           conv.StringListToStrings(d.Hosts),
+		Users:           conv.StringListToStrings(d.Users),
+	}
+
+	return osMapping
+}
+
+func terraformSecurityActionGroupToSecurityActionGroup(d *PluginSecurityActionGroupResourceData) client.PluginSecurityActionGroup {
+	osGroup := client.PluginSecurityActionGroup{
+		ActionGroupName: d.ActionGroupName.ValueString(),
+		Description:     d.Description.ValueString(),
+		Type:            d.Type.ValueString(),
+
+		AllowedActions: conv.StringListToStrings(d.AllowedActions),
+	}
+
+	return osGroup
+}
+
+func terraformSecurityTenantToSecurityTenant(d *PluginSecurityTenantResourceData) client.PluginSecurityTenant {
+	osTenant := client.PluginSecurityTenant{
+		TenantName:  d.TenantName.ValueString(),
+		Description: d.Description.ValueString(),
+	}
+
+	return osTenant
+}
+
+func terraformSecurityNodesDNToSecurityNodesDN(d *PluginSecurityNodesDNResourceData) client.PluginSecurityNodesDN {
+	osNodesDN := client.PluginSecurityNodesDN{
+		ClusterName: d.ClusterName.ValueString(),
+
+		NodesDN: conv.StringListToStrings(d.NodesDN),
+	}
+
+	return osNodesDN
+}
+
+// attributeValued returns true when the provided value is neither null nor unknown.  Unlike
+// conv.TestAttributeValueState, empty values are considered valued.
+func attributeValued(v attr.Value) bool {
+	return v != nil && !v.IsNull() && !v.IsUnknown()
+}
+
+func auditSettingsToTerraformObject(s client.PluginSecurityAuditSettings) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		auditSettingsAttrTypeMap,
+		map[string]attr.Value{
+			fields.ResourceAttrEnableRest:                  types.BoolValue(s.EnableRest),
+			fields.ResourceAttrDisabledRestCategories:      conv.StringsToStringList(s.DisabledRestCategories, false),
+			fields.ResourceAttrEnableTransport:             types.BoolValue(s.EnableTransport),
+			fields.ResourceAttrDisabledTransportCategories: conv.StringsToStringList(s.DisabledTransportCategories, false),
+			fields.ResourceAttrResolveBulkRequests:         types.BoolValue(s.ResolveBulkRequests),
+			fields.ResourceAttrLogRequestBody:              types.BoolValue(s.LogRequestBody),
+			fields.ResourceAttrResolveIndices:              types.BoolValue(s.ResolveIndices),
+			fields.ResourceAttrExcludeSensitiveHeaders:     types.BoolValue(s.ExcludeSensitiveHeaders),
+			fields.ResourceAttrIgnoreUsers:                 conv.StringsToStringList(s.IgnoreUsers, false),
+			fields.ResourceAttrIgnoreRequests:              conv.StringsToStringList(s.IgnoreRequests, false),
+		},
+	)
+}
+
+func complianceSettingsToTerraformObject(s client.PluginSecurityComplianceSettings) (types.Object, diag.Diagnostics) {
+	watchedFields := make(map[string]attr.Value, len(s.ReadWatchedFields))
+	for k, v := range s.ReadWatchedFields {
+		watchedFields[k] = conv.StringsToStringList(v, false)
+	}
+
+	watchedFieldsMap, diags := types.MapValue(types.ListType{ElemType: types.StringType}, watchedFields)
+	if diags.HasError() {
+		return types.ObjectNull(complianceSettingsAttrTypeMap), diags
+	}
+
+	return types.ObjectValue(
+		complianceSettingsAttrTypeMap,
+		map[string]attr.Value{
+			fields.ResourceAttrEnabled:             types.BoolValue(s.Enabled),
+			fields.ResourceAttrInternalConfig:      types.BoolValue(s.InternalConfig),
+			fields.ResourceAttrExternalConfig:      types.BoolValue(s.ExternalConfig),
+			fields.ResourceAttrReadMetadataOnly:    types.BoolValue(s.ReadMetadataOnly),
+			fields.ResourceAttrReadWatchedFields:   watchedFieldsMap,
+			fields.ResourceAttrReadIgnoreUsers:     conv.StringsToStringList(s.ReadIgnoreUsers, false),
+			fields.ResourceAttrWriteMetadataOnly:   types.BoolValue(s.WriteMetadataOnly),
+			fields.ResourceAttrWriteLogDiffs:       types.BoolValue(s.WriteLogDiffs),
+			fields.ResourceAttrWriteWatchedIndices: conv.StringsToStringList(s.WriteWatchedIndices, false),
+			fields.ResourceAttrWriteIgnoreUsers:    conv.StringsToStringList(s.WriteIgnoreUsers, false),
+		},
+	)
+}
+
+// overlayBool sets dst to the value of the named bool attribute, if it is valued
+func overlayBool(attrs map[string]attr.Value, name string, dst *bool) {
+	if v, ok := attrs[name]; ok && attributeValued(v) {
+		*dst = v.(types.Bool).ValueBool()
+	}
+}
+
+// overlayStrings sets dst to the value of the named string list attribute, if it is valued
+func overlayStrings(attrs map[string]attr.Value, name string, dst *[]string) {
+	if v, ok := attrs[name]; ok && attributeValued(v) {
+		*dst = conv.StringListToStrings(v)
+	}
+}
+
+func overlayTerraformAuditSettings(attrs map[string]attr.Value, dst *client.PluginSecurityAuditSettings) {
+	overlayBool(attrs, fields.ResourceAttrEnableRest, &dst.EnableRest)
+	overlayStrings(attrs, fields.ResourceAttrDisabledRestCategories, &dst.DisabledRestCategories)
+	overlayBool(attrs, fields.ResourceAttrEnableTransport, &dst.EnableTransport)
+	overlayStrings(attrs, fields.ResourceAttrDisabledTransportCategories, &dst.DisabledTransportCategories)
+	overlayBool(attrs, fields.ResourceAttrResolveBulkRequests, &dst.ResolveBulkRequests)
+	overlayBool(attrs, fields.ResourceAttrLogRequestBody, &dst.LogRequestBody)
+	overlayBool(attrs, fields.ResourceAttrResolveIndices, &dst.ResolveIndices)
+	overlayBool(attrs, fields.ResourceAttrExcludeSensitiveHeaders, &dst.ExcludeSensitiveHeaders)
+	overlayStrings(attrs, fields.ResourceAttrIgnoreUsers, &dst.IgnoreUsers)
+	overlayStrings(attrs, fields.ResourceAttrIgnoreRequests, &dst.IgnoreRequests)
+}
+
+func overlayTerraformComplianceSettings(attrs map[string]attr.Value, dst *client.PluginSecurityComplianceSettings) {
+	overlayBool(attrs, fields.ResourceAttrEnabled, &dst.Enabled)
+	overlayBool(attrs, fields.ResourceAttrInternalConfig, &dst.InternalConfig)
+	overlayBool(attrs, fields.ResourceAttrExternalConfig, &dst.ExternalConfig)
+	overlayBool(attrs, fields.ResourceAttrReadMetadataOnly, &dst.ReadMetadataOnly)
+	if v, ok := attrs[fields.ResourceAttrReadWatchedFields]; ok && attributeValued(v) {
+		elems := v.(types.Map).Elements()
+		dst.ReadWatchedFields = make(map[string][]string, len(elems))
+		for k, e := range elems {
+			dst.ReadWatchedFields[k] = conv.StringListToStrings(e)
+		}
+	}
+	overlayStrings(attrs, fields.ResourceAttrReadIgnoreUsers, &dst.ReadIgnoreUsers)
+	overlayBool(attrs, fields.ResourceAttrWriteMetadataOnly, &dst.WriteMetadataOnly)
+	overlayBool(attrs, fields.ResourceAttrWriteLogDiffs, &dst.WriteLogDiffs)
+	overlayStrings(attrs, fields.ResourceAttrWriteWatchedIndices, &dst.WriteWatchedIndices)
+	overlayStrings(attrs, fields.ResourceAttrWriteIgnoreUsers, &dst.WriteIgnoreUsers)
+}
+
+// overlayTerraformAuditConfig applies all known values from the plan on top of the provided config
+func overlayTerraformAuditConfig(d *PluginSecurityAuditConfigResourceData, dst *client.PluginSecurityAuditConfig) {
+	if attributeValued(d.Enabled) {
+		dst.Enabled = d.Enabled.ValueBool()
+	}
+	if attributeValued(d.Audit) {
+		overlayTerraformAuditSettings(d.Audit.Attributes(), &dst.Audit)
+	}
+	if attributeValued(d.Compliance) {
+		overlayTerraformComplianceSettings(d.Compliance.Attributes(), &dst.Compliance)
+	}
+}
+
+var (
+	pluginSecurityConfigBasicAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrChallenge: types.BoolType,
+	}
+
+	pluginSecurityConfigJWTAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrSigningKey:                   types.StringType,
+		fields.ResourceAttrJWTHeader:                    types.StringType,
+		fields.ResourceAttrJWTURLParameter:              types.StringType,
+		fields.ResourceAttrRolesKey:                     types.StringType,
+		fields.ResourceAttrSubjectKey:                   types.StringType,
+		fields.ResourceAttrJWTClockSkewToleranceSeconds: types.Int64Type,
+	}
+
+	pluginSecurityConfigOpenIDAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrOpenIDConnectURL:             types.StringType,
+		fields.ResourceAttrJWTHeader:                    types.StringType,
+		fields.ResourceAttrJWTURLParameter:              types.StringType,
+		fields.ResourceAttrRolesKey:                     types.StringType,
+		fields.ResourceAttrSubjectKey:                   types.StringType,
+		fields.ResourceAttrJWTClockSkewToleranceSeconds: types.Int64Type,
+		fields.ResourceAttrEnableSSL:                    types.BoolType,
+		fields.ResourceAttrVerifyHostnames:              types.BoolType,
+		fields.ResourceAttrPEMTrustedCAsContent:         types.StringType,
+	}
+
+	pluginSecurityConfigSAMLAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrIDPMetadataURL: types.StringType,
+		fields.ResourceAttrIDPEntityID:    types.StringType,
+		fields.ResourceAttrSPEntityID:     types.StringType,
+		fields.ResourceAttrKibanaURL:      types.StringType,
+		fields.ResourceAttrRolesKey:       types.StringType,
+		fields.ResourceAttrSubjectKey:     types.StringType,
+		fields.ResourceAttrExchangeKey:    types.StringType,
+	}
+
+	pluginSecurityConfigProxyAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrUserHeader:  types.StringType,
+		fields.ResourceAttrRolesHeader: types.StringType,
+	}
+
+	pluginSecurityConfigLDAPAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrHosts:                types.ListType{ElemType: types.StringType},
+		fields.ResourceAttrBindDN:               types.StringType,
+		fields.ResourceAttrPassword:             types.StringType,
+		fields.ResourceAttrEnableSSL:            types.BoolType,
+		fields.ResourceAttrEnableStartTLS:       types.BoolType,
+		fields.ResourceAttrVerifyHostnames:      types.BoolType,
+		fields.ResourceAttrPEMTrustedCAsContent: types.StringType,
+		fields.ResourceAttrUserBase:             types.StringType,
+		fields.ResourceAttrUserSearch:           types.StringType,
+		fields.ResourceAttrUsernameAttribute:    types.StringType,
+		fields.ResourceAttrRoleBase:             types.StringType,
+		fields.ResourceAttrRoleSearch:           types.StringType,
+		fields.ResourceAttrUserRoleAttribute:    types.StringType,
+		fields.ResourceAttrUserRoleName:         types.StringType,
+		fields.ResourceAttrLDAPRoleName:         types.StringType,
+		fields.ResourceAttrResolveNestedRoles:   types.BoolType,
+	}
+
+	pluginSecurityConfigAuthcDomainAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrName:                  types.StringType,
+		fields.ResourceAttrDescription:           types.StringType,
+		fields.ResourceAttrHTTPEnabled:           types.BoolType,
+		fields.ResourceAttrTransportEnabled:      types.BoolType,
+		fields.ResourceAttrOrder:                 types.Int64Type,
+		fields.ResourceAttrAuthenticationBackend: types.StringType,
+		fields.ResourceAttrBasic:                 types.ObjectType{AttrTypes: pluginSecurityConfigBasicAttrTypeMap},
+		fields.ResourceAttrJWT:                   types.ObjectType{AttrTypes: pluginSecurityConfigJWTAttrTypeMap},
+		fields.ResourceAttrOpenID:                types.ObjectType{AttrTypes: pluginSecurityConfigOpenIDAttrTypeMap},
+		fields.ResourceAttrSAML:                  types.ObjectType{AttrTypes: pluginSecurityConfigSAMLAttrTypeMap},
+		fields.ResourceAttrProxy:                 types.ObjectType{AttrTypes: pluginSecurityConfigProxyAttrTypeMap},
+		fields.ResourceAttrLDAP:                  types.ObjectType{AttrTypes: pluginSecurityConfigLDAPAttrTypeMap},
+	}
+
+	pluginSecurityConfigAuthzDomainAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrName:             types.StringType,
+		fields.ResourceAttrDescription:      types.StringType,
+		fields.ResourceAttrHTTPEnabled:      types.BoolType,
+		fields.ResourceAttrTransportEnabled: types.BoolType,
+		fields.ResourceAttrLDAP:             types.ObjectType{AttrTypes: pluginSecurityConfigLDAPAttrTypeMap},
+	}
+
+	pluginSecurityConfigHTTPAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrAnonymousAuthEnabled: types.BoolType,
+		fields.ResourceAttrXFFEnabled:           types.BoolType,
+		fields.ResourceAttrXFFInternalProxies:   types.StringType,
+		fields.ResourceAttrXFFRemoteIPHeader:    types.StringType,
+	}
+
+	pluginSecurityConfigKibanaAttrTypeMap = attrTypeMap{
+		fields.ResourceAttrMultitenancyEnabled:  types.BoolType,
+		fields.ResourceAttrPrivateTenantEnabled: types.BoolType,
+		fields.ResourceAttrDefaultTenant:        types.StringType,
+		fields.ResourceAttrServerUsername:       types.StringType,
+		fields.ResourceAttrIndex:                types.StringType,
+	}
+
+	// pluginSecurityConfigOpenIDPaths maps openid attributes that are not stored at the root of the authenticator config
+	pluginSecurityConfigOpenIDPaths = map[string][]string{
+		fields.ResourceAttrEnableSSL:            {"openid_connect_idp", "enable_ssl"},
+		fields.ResourceAttrVerifyHostnames:      {"openid_connect_idp", "verify_hostnames"},
+		fields.ResourceAttrPEMTrustedCAsContent: {"openid_connect_idp", "pemtrustedcas_content"},
+	}
+
+	// pluginSecurityConfigSAMLPaths maps saml attributes that are not stored at the root of the authenticator config
+	pluginSecurityConfigSAMLPaths = map[string][]string{
+		fields.ResourceAttrIDPMetadataURL: {"idp", "metadata_url"},
+		fields.ResourceAttrIDPEntityID:    {"idp", "entity_id"},
+		fields.ResourceAttrSPEntityID:     {"sp", "entity_id"},
+	}
+
+	// pluginSecurityConfigHTTPPaths maps http attributes to their location within config.dynamic
+	pluginSecurityConfigHTTPPaths = map[string][]string{
+		fields.ResourceAttrAnonymousAuthEnabled: {"http", "anonymous_auth_enabled"},
+		fields.ResourceAttrXFFEnabled:           {"http", "xff", "enabled"},
+		fields.ResourceAttrXFFInternalProxies:   {"http", "xff", "internalProxies"},
+		fields.ResourceAttrXFFRemoteIPHeader:    {"http", "xff", "remoteIpHeader"},
+	}
+
+	// pluginSecurityConfigKibanaPaths maps kibana attributes to their location within config.dynamic
+	pluginSecurityConfigKibanaPaths = map[string][]string{
+		fields.ResourceAttrMultitenancyEnabled:  {"kibana", "multitenancy_enabled"},
+		fields.ResourceAttrPrivateTenantEnabled: {"kibana", "private_tenant_enabled"},
+		fields.ResourceAttrDefaultTenant:        {"kibana", "default_tenant"},
+		fields.ResourceAttrServerUsername:       {"kibana", "server_username"},
+		fields.ResourceAttrIndex:                {"kibana", "index"},
+	}
+)
+
+// attrPath returns the config path for the named attribute, defaulting to a root key of the same name
+func attrPath(paths map[string][]string, name string) []string {
+	if p, ok := paths[name]; ok {
+		return p
+	}
+	return []string{name}
+}
+
+// terraformValueToConfigValue converts a valued scalar or string list attribute into its json-encodable equivalent
+func terraformValueToConfigValue(v attr.Value) (interface{}, bool) {
+	switch tv := v.(type) {
+	case types.String:
+		return tv.ValueString(), true
+	case types.Bool:
+		return tv.ValueBool(), true
+	case types.Int64:
+		return tv.ValueInt64(), true
+	case types.List:
+		return conv.StringListToStrings(tv), true
+	default:
+		return nil, false
+	}
+}
+
+// configValueToTerraformValue converts a decoded json value into an attribute of the provided type, returning a null
+// value when the input is missing or of an unexpected type
+func configValueToTerraformValue(t attr.Type, raw interface{}) attr.Value {
+	switch {
+	case t.Equal(types.StringType):
+		if s, ok := raw.(string); ok {
+			return types.StringValue(s)
+		}
+		return types.StringNull()
+	case t.Equal(types.BoolType):
+		if b, ok := raw.(bool); ok {
+			return types.BoolValue(b)
+		}
+		return types.BoolNull()
+	case t.Equal(types.Int64Type):
+		if n, ok := raw.(float64); ok {
+			return types.Int64Value(int64(n))
+		}
+		return types.Int64Null()
+	default:
+		if l, ok := raw.([]interface{}); ok {
+			elems := make([]attr.Value, 0, len(l))
+			for _, e := range l {
+				if s, ok := e.(string); ok {
+					elems = append(elems, types.StringValue(s))
+				}
+			}
+			return types.ListValueMust(types.StringType, elems)
+		}
+		return types.ListNull(types.StringType)
+	}
+}
+
+// terraformObjectToConfigMap converts the valued attributes of a typed authenticator or backend block into the
+// free-form config map expected by the security plugin
+func terraformObjectToConfigMap(obj types.Object, paths map[string][]string) map[string]interface{} {
+	out := make(map[string]interface{})
+	if !attributeValued(obj) {
+		return out
+	}
+
+	for name, v := range obj.Attributes() {
+		if !attributeValued(v) {
+			continue
+		}
+		cv, ok := terraformValueToConfigValue(v)
+		if !ok {
+			continue
+		}
+
+		// walk down to the parent of the target key, creating intermediate objects as necessary
+		p := attrPath(paths, name)
+		m := out
+		for _, k := range p[:len(p)-1] {
+			next, ok := m[k].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				m[k] = next
+			}
+			m = next
+		}
+		m[p[len(p)-1]] = cv
+	}
+
+	return out
+}
+
+// configMapToTerraformObject is the inverse of terraformObjectToConfigMap.  Attributes named as sensitive are taken
+// from the prior object, if there is one, as the security plugin does not reliably echo them back.
+func configMapToTerraformObject(attrTypes attrTypeMap, cfg map[string]interface{}, paths map[string][]string, prior types.Object, sensitive ...string) (types.Object, diag.Diagnostics) {
+	var priorAttrs map[string]attr.Value
+	if attributeValued(prior) {
+		priorAttrs = prior.Attributes()
+	}
+
+	attrs := make(map[string]attr.Value, len(attrTypes))
+
+attrLoop:
+	for name, t := range attrTypes {
+		if priorAttrs != nil {
+			for _, s := range sensitive {
+				if s == name {
+					attrs[name] = priorAttrs[name]
+					continue attrLoop
+				}
+			}
+		}
+
+		// walk down to the target key
+		var raw interface{} = cfg
+		for _, k := range attrPath(paths, name) {
+			m, ok := raw.(map[string]interface{})
+			if !ok {
+				raw = nil
+				break
+			}
+			raw = m[k]
+		}
+
+		attrs[name] = configValueToTerraformValue(t, raw)
+	}
+
+	return types.ObjectValue(attrTypes, attrs)
+}
+
+// terraformObjectToPatchOperations creates an "add" operation for each valued attribute of the provided object
+func terraformObjectToPatchOperations(obj types.Object, paths map[string][]string) []client.PluginSecurityConfigPatchOperation {
+	if !attributeValued(obj) {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+
+	// sort names so the resulting patch is stable
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ops := make([]client.PluginSecurityConfigPatchOperation, 0, len(names))
+	for _, name := range names {
+		if !attributeValued(attrs[name]) {
+			continue
+		}
+		if cv, ok := terraformValueToConfigValue(attrs[name]); ok {
+			ops = append(ops, client.PluginSecurityConfigPatchOperation{
+				Op:    "add",
+				Path:  client.PluginSecurityConfigDynamicPath(attrPath(paths, name)...),
+				Value: cv,
+			})
+		}
+	}
+
+	return ops
+}
+
+func pluginSecurityConfigHTTPToTerraformObject(h client.PluginSecurityConfigHTTP) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		pluginSecurityConfigHTTPAttrTypeMap,
+		map[string]attr.Value{
+			fields.ResourceAttrAnonymousAuthEnabled: types.BoolValue(h.AnonymousAuthEnabled),
+			fields.ResourceAttrXFFEnabled:           types.BoolValue(h.XFF.Enabled),
+			fields.ResourceAttrXFFInternalProxies:   types.StringValue(h.XFF.InternalProxies),
+			fields.ResourceAttrXFFRemoteIPHeader:    types.StringValue(h.XFF.RemoteIPHeader),
+		},
+	)
+}
+
+func pluginSecurityConfigKibanaToTerraformObject(k client.PluginSecurityConfigKibana) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		pluginSecurityConfigKibanaAttrTypeMap,
+		map[string]attr.Value{
+			fields.ResourceAttrMultitenancyEnabled:  types.BoolValue(k.MultitenancyEnabled),
+			fields.ResourceAttrPrivateTenantEnabled: types.BoolValue(k.PrivateTenantEnabled),
+			fields.ResourceAttrDefaultTenant:        types.StringValue(k.DefaultTenant),
+			fields.ResourceAttrServerUsername:       types.StringValue(k.ServerUsername),
+			fields.ResourceAttrIndex:                types.StringValue(k.Index),
+		},
+	)
+}
+
+func terraformAuthcDomainToAuthcDomain(d pluginSecurityConfigAuthcDomainData) client.PluginSecurityConfigAuthcDomain {
+	out := client.PluginSecurityConfigAuthcDomain{
+		Description:      d.Description.ValueString(),
+		HTTPEnabled:      d.HTTPEnabled.ValueBool(),
+		TransportEnabled: d.TransportEnabled.ValueBool(),
+		Order:            d.Order.ValueInt64(),
+	}
+
+	switch {
+	case attributeValued(d.Basic):
+		out.HTTPAuthenticator = client.PluginSecurityConfigHTTPAuthenticator{
+			Type:      "basic",
+			Challenge: true,
+			Config:    map[string]interface{}{},
+		}
+		if v := d.Basic.Attributes()[fields.ResourceAttrChallenge]; attributeValued(v) {
+			out.HTTPAuthenticator.Challenge = v.(types.Bool).ValueBool()
+		}
+	case attributeValued(d.JWT):
+		out.HTTPAuthenticator = client.PluginSecurityConfigHTTPAuthenticator{
+			Type:   "jwt",
+			Config: terraformObjectToConfigMap(d.JWT, nil),
+		}
+	case attributeValued(d.OpenID):
+		out.HTTPAuthenticator = client.PluginSecurityConfigHTTPAuthenticator{
+			Type:   "openid",
+			Config: terraformObjectToConfigMap(d.OpenID, pluginSecurityConfigOpenIDPaths),
+		}
+	case attributeValued(d.SAML):
+		out.HTTPAuthenticator = client.PluginSecurityConfigHTTPAuthenticator{
+			Type:      "saml",
+			Challenge: true,
+			Config:    terraformObjectToConfigMap(d.SAML, pluginSecurityConfigSAMLPaths),
+		}
+	case attributeValued(d.Proxy):
+		out.HTTPAuthenticator = client.PluginSecurityConfigHTTPAuthenticator{
+			Type:   "proxy",
+			Config: terraformObjectToConfigMap(d.Proxy, nil),
+		}
+	}
+
+	switch {
+	case attributeValued(d.LDAP):
+		out.AuthenticationBackend = client.PluginSecurityConfigBackend{
+			Type:   "ldap",
+			Config: terraformObjectToConfigMap(d.LDAP, nil),
+		}
+	case attributeValued(d.AuthenticationBackend):
+		out.AuthenticationBackend = client.PluginSecurityConfigBackend{
+			Type:   d.AuthenticationBackend.ValueString(),
+			Config: map[string]interface{}{},
+		}
+	case attributeValued(d.Basic):
+		// basic auth is checked against the internal user database by default
+		out.AuthenticationBackend = client.PluginSecurityConfigBackend{
+			Type:   "intern",
+			Config: map[string]interface{}{},
+		}
+	default:
+		// token-based authenticators have already verified the user
+		out.AuthenticationBackend = client.PluginSecurityConfigBackend{
+			Type:   "noop",
+			Config: map[string]interface{}{},
+		}
+	}
+
+	return out
+}
+
+func authcDomainToTerraformAuthcDomain(name string, d client.PluginSecurityConfigAuthcDomain, prior pluginSecurityConfigAuthcDomainData) (pluginSecurityConfigAuthcDomainData, diag.Diagnostics) {
+	var (
+		out = pluginSecurityConfigAuthcDomainData{
+			Name:                  types.StringValue(name),
+			Description:           types.StringNull(),
+			HTTPEnabled:           types.BoolValue(d.HTTPEnabled),
+			TransportEnabled:      types.BoolValue(d.TransportEnabled),
+			Order:                 types.Int64Value(d.Order),
+			AuthenticationBackend: types.StringValue(d.AuthenticationBackend.Type),
+			Basic:                 types.ObjectNull(pluginSecurityConfigBasicAttrTypeMap),
+			JWT:                   types.ObjectNull(pluginSecurityConfigJWTAttrTypeMap),
+			OpenID:                types.ObjectNull(pluginSecurityConfigOpenIDAttrTypeMap),
+			SAML:                  types.ObjectNull(pluginSecurityConfigSAMLAttrTypeMap),
+			Proxy:                 types.ObjectNull(pluginSecurityConfigProxyAttrTypeMap),
+			LDAP:                  types.ObjectNull(pluginSecurityConfigLDAPAttrTypeMap),
+		}
+		diags diag.Diagnostics
+	)
+
+	if d.Description != "" {
+		out.Description = types.StringValue(d.Description)
+	}
+
+	authn := d.HTTPAuthenticator
+	switch authn.Type {
+	case "basic":
+		out.Basic, diags = types.ObjectValue(
+			pluginSecurityConfigBasicAttrTypeMap,
+			map[string]attr.Value{
+				fields.ResourceAttrChallenge: types.BoolValue(authn.Challenge),
+			},
+		)
+	case "jwt":
+		out.JWT, diags = configMapToTerraformObject(pluginSecurityConfigJWTAttrTypeMap, authn.Config, nil, prior.JWT, fields.ResourceAttrSigningKey)
+	case "openid":
+		out.OpenID, diags = configMapToTerraformObject(pluginSecurityConfigOpenIDAttrTypeMap, authn.Config, pluginSecurityConfigOpenIDPaths, prior.OpenID)
+	case "saml":
+		out.SAML, diags = configMapToTerraformObject(pluginSecurityConfigSAMLAttrTypeMap, authn.Config, pluginSecurityConfigSAMLPaths, prior.SAML, fields.ResourceAttrExchangeKey)
+	case "proxy":
+		out.Proxy, diags = configMapToTerraformObject(pluginSecurityConfigProxyAttrTypeMap, authn.Config, nil, prior.Proxy)
+	default:
+		diags.AddWarning(
+			"Unsupported HTTP authenticator type",
+			fmt.Sprintf("HTTP authenticator type %q is not supported by this provider and cannot be represented in state", authn.Type),
+		)
+	}
+	if diags.HasError() {
+		return out, diags
+	}
+
+	if d.AuthenticationBackend.Type == "ldap" {
+		var ldapDiags diag.Diagnostics
+		out.LDAP, ldapDiags = configMapToTerraformObject(pluginSecurityConfigLDAPAttrTypeMap, d.AuthenticationBackend.Config, nil, prior.LDAP, fields.ResourceAttrPassword)
+		diags.Append(ldapDiags...)
+	}
+
+	return out, diags
+}
+
+func terraformAuthzDomainToAuthzDomain(d pluginSecurityConfigAuthzDomainData) client.PluginSecurityConfigAuthzDomain {
+	return client.PluginSecurityConfigAuthzDomain{
+		Description:      d.Description.ValueString(),
+		HTTPEnabled:      d.HTTPEnabled.ValueBool(),
+		TransportEnabled: d.TransportEnabled.ValueBool(),
+		AuthorizationBackend: client.PluginSecurityConfigBackend{
+			Type:   "ldap",
+			Config: terraformObjectToConfigMap(d.LDAP, nil),
+		},
+	}
+}
+
+func authzDomainToTerraformAuthzDomain(name string, d client.PluginSecurityConfigAuthzDomain, prior pluginSecurityConfigAuthzDomainData) (pluginSecurityConfigAuthzDomainData, diag.Diagnostics) {
+	var (
+		out = pluginSecurityConfigAuthzDomainData{
+			Name:             types.StringValue(name),
+			Description:      types.StringNull(),
+			HTTPEnabled:      types.BoolValue(d.HTTPEnabled),
+			TransportEnabled: types.BoolValue(d.TransportEnabled),
+			LDAP:             types.ObjectNull(pluginSecurityConfigLDAPAttrTypeMap),
+		}
+		diags diag.Diagnostics
+	)
+
+	if d.Description != "" {
+		out.Description = types.StringValue(d.Description)
+	}
+
+	if d.AuthorizationBackend.Type == "ldap" {
+		out.LDAP, diags = configMapToTerraformObject(pluginSecurityConfigLDAPAttrTypeMap, d.AuthorizationBackend.Config, nil, prior.LDAP, fields.ResourceAttrPassword)
+	} else {
+		diags.AddWarning(
+			"Unsupported authorization backend type",
+			fmt.Sprintf("Authorization backend type %q is not supported by this provider and cannot be represented in state", d.AuthorizationBackend.Type),
+		)
+	}
+
+	return out, diags
+}
+
+// overlayTerraformAllowlist applies all known values from the plan on top of the provided config
+func overlayTerraformAllowlist(d *PluginSecurityAllowlistResourceData, dst *client.PluginSecurityAllowlistConfig) {
+	if attributeValued(d.Enabled) {
+		dst.Enabled = d.Enabled.ValueBool()
+	}
+	if attributeValued(d.Requests) {
+		elems := d.Requests.Elements()
+		dst.Requests = make(map[string][]string, len(elems))
+		for k, e := range elems {
+			dst.Requests[k] = conv.StringListToStrings(e)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewPluginSecurityRoleDataSource() datasource.DataSource {
+	d := new(PluginSecurityRoleDataSource)
+	d.typeName = fields.TypeName(fields.ProviderName, fields.DataSourceTypeSecurityPluginRole)
+	d.minimumVersion = minimumPluginSecurityVersion
+	return d
+}
+
+type PluginSecurityRoleDataSource struct {
+	DataSourceShared
+}
+
+func (d *PluginSecurityRoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.DataSourceTypeSecurityPluginRole)
+}
+
+func (d *PluginSecurityRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin Role.  Can be used to reference any role, including reserved roles" +
+			" such as all_access.",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrRoleName: schema.StringAttribute{
+				Description: "Name of the role to read",
+				Required:    true,
+				Validators: []validator.String{
+					validation.Required(),
+				},
+			},
+			fields.ResourceAttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrClusterPermissions: schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrIndexPermissions: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pluginSecurityRoleIndexPermissionDataSourceAttributes(),
+				},
+			},
+			fields.ResourceAttrTenantPermissions: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pluginSecurityRoleTenantPermissionDataSourceAttributes(),
+				},
+			},
+			fields.ResourceAttrStatic: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrHidden: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrReserved: schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: dataSourceTimeoutsBlock(),
+		},
+	}
+}
+
+func pluginSecurityRoleIndexPermissionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		fields.ResourceAttrIndexPatterns: schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		fields.ResourceAttrDLS: schema.StringAttribute{
+			Computed: true,
+		},
+		fields.ResourceAttrFLS: schema.StringAttribute{
+			Computed: true,
+		},
+		fields.ResourceAttrMaskedFields: schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		fields.ResourceAttrAllowedActions: schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func pluginSecurityRoleTenantPermissionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		fields.ResourceAttrTenantPatterns: schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		fields.ResourceAttrAllowedActions: schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (d *PluginSecurityRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		roleName string
+		osRole   client.PluginSecurityRole
+		ok       bool
+
+		// the data source shares its model with the role resource
+		confData = new(PluginSecurityRoleResourceData)
+	)
+
+	// ensure the cluster supports this data source
+	d.checkMinimumVersion(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// marshal config value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Config.Get(ctx, confData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := d.operationDeadline(confData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = d.operationContext(ctx, fields.ResourceAttrRead)
+
+	// extract role name
+	roleName = confData.RoleName.ValueString()
+
+	// query for role from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osRoles, err := tryFetchRoles(ctx, d.client, roleName)
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Role not found",
+					fmt.Sprintf("Role %q not found", roleName),
+				)
+			} else if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for role",
+					fmt.Sprintf("Error occurred querying for role %q: %v", roleName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract role from response
+		if osRole, ok = osRoles[roleName]; !ok {
+			resp.Diagnostics.AddError(
+				"Role not found",
+				fmt.Sprintf("Role %q not found", roleName),
+			)
+			return
+		}
+	}
+
+	// update data object from source role
+	resp.Diagnostics.Append(confData.UpdateFromRole(roleName, osRole)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, confData)...)
+}
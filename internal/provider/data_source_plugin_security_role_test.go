@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityRoleDataSource(t *testing.T) {
+	const (
+		dataSourceName = "test_role"
+	)
+
+	var (
+		dataSourceFQN = fields.DatasourceTypeFQN(fields.ProviderName, fields.DataSourceTypeSecurityPluginRole, dataSourceName)
+	)
+
+	t.Run("empty-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRoleDataSourceConfigWith(dataSourceName, nil),
+					),
+					ExpectError: regexp.MustCompile("required"),
+				},
+			},
+		})
+	})
+
+	t.Run("all-access", func(t *testing.T) {
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRoleDataSourceConfigWith(dataSourceName, map[string]interface{}{
+							fields.ResourceAttrRoleName: "all_access",
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceFQN, fields.ResourceAttrID, "all_access"),
+						resource.TestCheckResourceAttr(dataSourceFQN, fields.ResourceAttrReserved, "true"),
+						resource.TestCheckResourceAttr(dataSourceFQN, fmt.Sprintf("%s.0", fields.ResourceAttrClusterPermissions), "*"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("missing-throws-error", func(t *testing.T) {
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRoleDataSourceConfigWith(dataSourceName, map[string]interface{}{
+							fields.ResourceAttrRoleName: "this_role_does_not_exist",
+						}),
+					),
+					ExpectError: regexp.MustCompile("not found"),
+				},
+			},
+		})
+	})
+}
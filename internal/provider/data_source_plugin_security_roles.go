@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewPluginSecurityRolesDataSource() datasource.DataSource {
+	d := new(PluginSecurityRolesDataSource)
+	d.typeName = fields.TypeName(fields.ProviderName, fields.DataSourceTypeSecurityPluginRoles)
+	d.minimumVersion = minimumPluginSecurityVersion
+	return d
+}
+
+type PluginSecurityRolesDataSource struct {
+	DataSourceShared
+}
+
+type PluginSecurityRolesDataSourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	NameRegex types.String `tfsdk:"name_regex"`
+	Reserved  types.Bool   `tfsdk:"reserved"`
+	Hidden    types.Bool   `tfsdk:"hidden"`
+
+	Names types.List `tfsdk:"names"`
+	Roles types.Map  `tfsdk:"roles"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (d *PluginSecurityRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.DataSourceTypeSecurityPluginRoles)
+}
+
+func (d *PluginSecurityRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin Roles.  Lists all roles in the cluster, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrNameRegex: schema.StringAttribute{
+				Description: "Only include roles whose name matches this regular expression",
+				Optional:    true,
+			},
+			fields.ResourceAttrReserved: schema.BoolAttribute{
+				Description: "When set, only include roles whose reserved flag equals this value",
+				Optional:    true,
+			},
+			fields.ResourceAttrHidden: schema.BoolAttribute{
+				Description: "When set, only include roles whose hidden flag equals this value",
+				Optional:    true,
+			},
+			fields.ResourceAttrNames: schema.ListAttribute{
+				Description: "Sorted names of the matching roles",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrRoles: schema.MapNestedAttribute{
+				Description: "Matching roles, keyed by name",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						fields.ResourceAttrDescription: schema.StringAttribute{
+							Computed: true,
+						},
+						fields.ResourceAttrClusterPermissions: schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						fields.ResourceAttrIndexPermissions: schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: pluginSecurityRoleIndexPermissionDataSourceAttributes(),
+							},
+						},
+						fields.ResourceAttrTenantPermissions: schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: pluginSecurityRoleTenantPermissionDataSourceAttributes(),
+							},
+						},
+						fields.ResourceAttrStatic: schema.BoolAttribute{
+							Computed: true,
+						},
+						fields.ResourceAttrHidden: schema.BoolAttribute{
+							Computed: true,
+						},
+						fields.ResourceAttrReserved: schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: dataSourceTimeoutsBlock(),
+		},
+	}
+}
+
+// includeRole returns true if the role passes all configured filters
+func (d *PluginSecurityRolesDataSourceData) includeRole(nameRegex *regexp.Regexp, roleName string, r client.PluginSecurityRole) bool {
+	if nameRegex != nil && !nameRegex.MatchString(roleName) {
+		return false
+	}
+	if attributeValued(d.Reserved) && conv.BoolPtrToBoolValue(r.Reserved).ValueBool() != d.Reserved.ValueBool() {
+		return false
+	}
+	if attributeValued(d.Hidden) && conv.BoolPtrToBoolValue(r.Hidden).ValueBool() != d.Hidden.ValueBool() {
+		return false
+	}
+	return true
+}
+
+func (d *PluginSecurityRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		nameRegex *regexp.Regexp
+		osRoles   client.PluginSecurityRolesAPIResponse
+		err       error
+
+		confData = new(PluginSecurityRolesDataSourceData)
+	)
+
+	// ensure the cluster supports this data source
+	d.checkMinimumVersion(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// marshal config value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Config.Get(ctx, confData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := d.operationDeadline(confData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = d.operationContext(ctx, fields.ResourceAttrRead)
+
+	// compile name filter, if provided
+	if attributeValued(confData.NameRegex) {
+		if nameRegex, err = regexp.Compile(confData.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(fields.ResourceAttrNameRegex),
+				"Invalid name regex",
+				fmt.Sprintf("Unable to compile %q: %v", confData.NameRegex.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	// query for all roles from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		if _, osRoles, err = tryFetchRoles(ctx, d.client, ""); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for roles",
+					fmt.Sprintf("Error occurred querying for roles: %v", err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// filter and convert
+	names := make([]string, 0, len(osRoles))
+	roles := make(map[string]attr.Value, len(osRoles))
+	for roleName, osRole := range osRoles {
+		if !confData.includeRole(nameRegex, roleName, osRole) {
+			continue
+		}
+		obj, diags := roleToTerraformObject(osRole)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		names = append(names, roleName)
+		roles[roleName] = obj
+	}
+	sort.Strings(names)
+
+	// the list of roles is not a single object, so use a static id
+	confData.ID = types.StringValue(fields.DataSourceTypeSecurityPluginRoles)
+	confData.Names = conv.StringsToStringList(names, false)
+
+	rolesMap, diags := types.MapValue(types.ObjectType{AttrTypes: roleAttrTypeMap}, roles)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	confData.Roles = rolesMap
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, confData)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityRolesDataSource(t *testing.T) {
+	const (
+		dataSourceName = "test_roles"
+	)
+
+	var (
+		dataSourceFQN = fields.DatasourceTypeFQN(fields.ProviderName, fields.DataSourceTypeSecurityPluginRoles, dataSourceName)
+	)
+
+	t.Run("invalid-regex-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRolesDataSourceConfigWith(dataSourceName, map[string]interface{}{
+							fields.ResourceAttrNameRegex: "all_(",
+						}),
+					),
+					ExpectError: regexp.MustCompile("Invalid name regex"),
+				},
+			},
+		})
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRolesDataSourceConfigWith(dataSourceName, map[string]interface{}{
+							fields.ResourceAttrNameRegex: "^all_access$",
+							fields.ResourceAttrReserved:  true,
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceFQN, fmt.Sprintf("%s.#", fields.ResourceAttrNames), "1"),
+						resource.TestCheckResourceAttr(dataSourceFQN, fmt.Sprintf("%s.0", fields.ResourceAttrNames), "all_access"),
+						resource.TestCheckResourceAttr(dataSourceFQN, fmt.Sprintf("%s.all_access.%s", fields.ResourceAttrRoles, fields.ResourceAttrReserved), "true"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -30,3 +30,173 @@ func tryFetchRoles(ctx context.Context, osClient *opensearch.Client, roleName st
 
 	return osResp, roleResp, nil
 }
+
+func tryFetchUsers(ctx context.Context, osClient *opensearch.Client, username string) (*opensearchapi.Response, client.PluginSecurityUsersAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityUsersGetRequest{
+		Name: username,
+	}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	userResp := make(client.PluginSecurityUsersAPIResponse)
+	if err = client.ParseResponse(osResp, &userResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, userResp, nil
+}
+
+func tryFetchRoleMappings(ctx context.Context, osClient *opensearch.Client, roleName string) (*opensearchapi.Response, client.PluginSecurityRoleMappingsAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityRoleMappingsGetRequest{
+		Name: roleName,
+	}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	mappingResp := make(client.PluginSecurityRoleMappingsAPIResponse)
+	if err = client.ParseResponse(osResp, &mappingResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, mappingResp, nil
+}
+
+func tryFetchActionGroups(ctx context.Context, osClient *opensearch.Client, actionGroupName string) (*opensearchapi.Response, client.PluginSecurityActionGroupsAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityActionGroupsGetRequest{
+		Name: actionGroupName,
+	}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	groupResp := make(client.PluginSecurityActionGroupsAPIResponse)
+	if err = client.ParseResponse(osResp, &groupResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, groupResp, nil
+}
+
+func tryFetchTenants(ctx context.Context, osClient *opensearch.Client, tenantName string) (*opensearchapi.Response, client.PluginSecurityTenantsAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityTenantsGetRequest{
+		Name: tenantName,
+	}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	tenantResp := make(client.PluginSecurityTenantsAPIResponse)
+	if err = client.ParseResponse(osResp, &tenantResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, tenantResp, nil
+}
+
+func tryFetchNodesDN(ctx context.Context, osClient *opensearch.Client, clusterName string) (*opensearchapi.Response, client.PluginSecurityNodesDNAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityNodesDNGetRequest{
+		Name: clusterName,
+	}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	nodesDNResp := make(client.PluginSecurityNodesDNAPIResponse)
+	if err = client.ParseResponse(osResp, &nodesDNResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, nodesDNResp, nil
+}
+
+func tryFetchAuditConfig(ctx context.Context, osClient *opensearch.Client) (*opensearchapi.Response, *client.PluginSecurityAuditAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityAuditGetRequest{}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	auditResp := new(client.PluginSecurityAuditAPIResponse)
+	if err = client.ParseResponse(osResp, auditResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, auditResp, nil
+}
+
+func tryFetchAllowlist(ctx context.Context, osClient *opensearch.Client) (*opensearchapi.Response, *client.PluginSecurityAllowlistAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityAllowlistGetRequest{}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	allowlistResp := new(client.PluginSecurityAllowlistAPIResponse)
+	if err = client.ParseResponse(osResp, allowlistResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, allowlistResp, nil
+}
+
+func tryFetchSecurityConfig(ctx context.Context, osClient *opensearch.Client) (*opensearchapi.Response, *client.PluginSecurityConfigAPIResponse, error) {
+	// init opensearch request
+	osReq := client.PluginSecurityConfigGetRequest{}
+
+	osResp, err := osReq.Do(ctx, osClient)
+	if err != nil {
+		// just in case response isn't nil
+		defer client.HandleResponseCleanup(osResp)
+		return nil, nil, err
+	}
+
+	// attempt to decode response
+	configResp := new(client.PluginSecurityConfigAPIResponse)
+	if err = client.ParseResponse(osResp, configResp, http.StatusOK); err != nil {
+		return osResp, nil, err
+	}
+
+	return osResp, configResp, nil
+}
@@ -59,3 +59,21 @@ func (m defaultValuedStringPlanModifier) PlanModifyString(_ context.Context, req
 		resp.PlanValue = types.StringValue(string(m))
 	}
 }
+
+type defaultValuedBoolPlanModifier bool
+
+func (defaultValuedBoolPlanModifier) Description(context.Context) string {
+	const d = "Sets a default value when no value is configured"
+	return d
+}
+
+func (defaultValuedBoolPlanModifier) MarkdownDescription(context.Context) string {
+	const d = "Sets a default value when no value is configured"
+	return d
+}
+
+func (m defaultValuedBoolPlanModifier) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.BoolValue(bool(m))
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const defaultAuthHeader = "Authorization"
+
+// validHeaderName performs a minimal check of a header name, rejecting values that would corrupt the request
+func validHeaderName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, " \t\r\n:")
+}
+
+// buildRequestHeaders returns the headers set on every request sent to OpenSearch, built from the provider's headers
+// map and token.  The token is sent in the Authorization header as a bearer token unless auth_header names another
+// header, in which case it is sent as-is.
+func buildRequestHeaders(conf OpenSearchProviderConfig, diags *diag.Diagnostics) http.Header {
+	header := make(http.Header)
+
+	for k, v := range terraformStringMapToStrings(conf.Headers) {
+		if !validHeaderName(k) {
+			diags.AddAttributeError(
+				path.Root(fields.ConfigAttrHeaders),
+				"Invalid header name",
+				fmt.Sprintf("Header name %q must not be empty or contain whitespace or colons", k),
+			)
+			continue
+		}
+		header.Set(k, v)
+	}
+
+	authHeader := defaultAuthHeader
+	if attributeValued(conf.AuthHeader) {
+		if !attributeValued(conf.Token) {
+			diags.AddAttributeError(
+				path.Root(fields.ConfigAttrAuthHeader),
+				"Missing token",
+				fmt.Sprintf("%s may only be set with %s", fields.ConfigAttrAuthHeader, fields.ConfigAttrToken),
+			)
+			return nil
+		}
+		if authHeader = conf.AuthHeader.ValueString(); !validHeaderName(authHeader) {
+			diags.AddAttributeError(
+				path.Root(fields.ConfigAttrAuthHeader),
+				"Invalid header name",
+				fmt.Sprintf("Header name %q must not be empty or contain whitespace or colons", authHeader),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	if attributeValued(conf.Token) {
+		if token := conf.Token.ValueString(); http.CanonicalHeaderKey(authHeader) == defaultAuthHeader {
+			header.Set(authHeader, "Bearer "+token)
+		} else {
+			header.Set(authHeader, token)
+		}
+	}
+
+	return header
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opensearch-project/opensearch-go"
+)
+
+func TestUnit_BuildRequestHeaders(t *testing.T) {
+	build := func(conf OpenSearchProviderConfig) (http.Header, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		return buildRequestHeaders(conf, &diags), diags
+	}
+
+	t.Run("bearer-token", func(t *testing.T) {
+		header, diags := build(OpenSearchProviderConfig{
+			Token: types.StringValue("eyJhbGciOi"),
+			Headers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"X-Tenant":      types.StringValue("global"),
+				"Authorization": types.StringValue("Basic nope"),
+			}),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if v := header.Get("Authorization"); v != "Bearer eyJhbGciOi" {
+			t.Errorf("expected bearer token, saw %q", v)
+		}
+		if v := header.Get("X-Tenant"); v != "global" {
+			t.Errorf("expected X-Tenant %q, saw %q", "global", v)
+		}
+	})
+
+	t.Run("auth-header", func(t *testing.T) {
+		header, diags := build(OpenSearchProviderConfig{
+			Token:      types.StringValue("eyJhbGciOi"),
+			AuthHeader: types.StringValue("X-JWT"),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if v := header.Get("X-JWT"); v != "eyJhbGciOi" {
+			t.Errorf("expected raw token, saw %q", v)
+		}
+		if v := header.Get("Authorization"); v != "" {
+			t.Errorf("expected no Authorization header, saw %q", v)
+		}
+	})
+
+	t.Run("invalid-values-throw-errors", func(t *testing.T) {
+		for name, conf := range map[string]OpenSearchProviderConfig{
+			"auth-header-without-token": {AuthHeader: types.StringValue("X-JWT")},
+			"auth-header-name":          {Token: types.StringValue("t"), AuthHeader: types.StringValue("X JWT")},
+			"header-name": {Headers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"X-Tenant:": types.StringValue("global"),
+			})},
+		} {
+			if _, diags := build(conf); !diags.HasError() {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+
+	t.Run("applied-to-custom-requests", func(t *testing.T) {
+		var seen http.Header
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen = r.Header.Clone()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		header, diags := build(OpenSearchProviderConfig{Token: types.StringValue("eyJhbGciOi")})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		osClient, err := opensearch.NewClient(opensearch.Config{
+			Addresses:            []string{srv.URL},
+			Transport:            client.NewHeaderTransport(http.DefaultTransport, header),
+			Username:             "admin",
+			Password:             "admin",
+			UseResponseCheckOnly: true,
+		})
+		if err != nil {
+			t.Fatalf("error constructing client: %v", err)
+		}
+
+		resp, err := client.PluginSecurityRolesGetRequest{Name: "my-role"}.Do(context.Background(), osClient)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		client.HandleResponseCleanup(resp)
+		if v := seen.Get("Authorization"); v != "Bearer eyJhbGciOi" {
+			t.Errorf("expected bearer token to replace basic auth, saw %q", v)
+		}
+	})
+}
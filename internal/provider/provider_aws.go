@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const defaultAWSAssumeRoleSessionName = "terraform-provider-opensearch"
+
+var awsServices = []string{client.AWSServiceOpenSearch, client.AWSServiceOpenSearchServerless}
+
+// buildAWSSigV4Transport wraps the provided transport with one that signs each request using the credentials
+// described by the provider's aws block.  Static keys take precedence, otherwise credentials are resolved by the AWS
+// SDK's default chain: environment variables, the shared config and credentials files (including SSO and web
+// identity profiles), and ECS or EC2 instance roles.  Calls made to AWS while resolving credentials use the SDK's own
+// transport, sharing only the proxy with the OpenSearch transport, as the CA certificates, public key pins and client
+// certificate configured for OpenSearch do not apply to AWS.
+func buildAWSSigV4Transport(ctx context.Context, transport http.RoundTripper, proxy func(*http.Request) (*url.URL, error), awsConf OpenSearchProviderConfigAWS, diags *diag.Diagnostics) http.RoundTripper {
+	var (
+		opts    []func(*config.LoadOptions) error
+		awsPath = path.Root(fields.ConfigAttrAWS)
+	)
+
+	service := client.AWSServiceOpenSearch
+	if attributeValued(awsConf.Service) {
+		service = awsConf.Service.ValueString()
+		if service != client.AWSServiceOpenSearch && service != client.AWSServiceOpenSearchServerless {
+			diags.AddAttributeError(
+				awsPath.AtName(fields.ConfigAttrService),
+				"Invalid AWS service",
+				fmt.Sprintf("Service %q must be one of: %q, %q", service, client.AWSServiceOpenSearch, client.AWSServiceOpenSearchServerless),
+			)
+			return nil
+		}
+	}
+
+	opts = append(opts, config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions(func(t *http.Transport) {
+		t.Proxy = proxy
+	})))
+	if attributeValued(awsConf.Region) && awsConf.Region.ValueString() != "" {
+		opts = append(opts, config.WithRegion(awsConf.Region.ValueString()))
+	}
+	if attributeValued(awsConf.Profile) && awsConf.Profile.ValueString() != "" {
+		opts = append(opts, config.WithSharedConfigProfile(awsConf.Profile.ValueString()))
+	}
+
+	if attributeValued(awsConf.AccessKey) || attributeValued(awsConf.SecretKey) {
+		if !attributeValued(awsConf.AccessKey) || !attributeValued(awsConf.SecretKey) {
+			diags.AddAttributeError(
+				awsPath,
+				"Incomplete AWS credentials",
+				fmt.Sprintf("Both %s and %s must be set to use static AWS credentials", fields.ConfigAttrAccessKey, fields.ConfigAttrSecretKey),
+			)
+			return nil
+		}
+		opts = append(opts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			awsConf.AccessKey.ValueString(),
+			awsConf.SecretKey.ValueString(),
+			awsConf.SessionToken.ValueString(),
+		)))
+	}
+
+	awsConfig, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		diags.AddAttributeError(
+			awsPath,
+			"Error loading AWS configuration",
+			fmt.Sprintf("Unable to load AWS configuration: %v", err),
+		)
+		return nil
+	}
+
+	if awsConfig.Region == "" {
+		diags.AddAttributeError(
+			awsPath.AtName(fields.ConfigAttrRegion),
+			"Missing AWS region",
+			fmt.Sprintf("A region must be provided with the %s attribute, the %s environment variable or the shared config file to sign requests", fields.ConfigAttrRegion, fields.EnvAWSRegion),
+		)
+		return nil
+	}
+
+	if awsConfig.Credentials == nil {
+		diags.AddAttributeError(
+			awsPath,
+			"Missing AWS credentials",
+			"No AWS credentials could be found to sign requests",
+		)
+		return nil
+	}
+
+	if attributeValued(awsConf.AssumeRoleARN) {
+		stsClient := sts.NewFromConfig(awsConfig, func(o *sts.Options) {
+			if attributeValued(awsConf.STSEndpoint) && awsConf.STSEndpoint.ValueString() != "" {
+				o.BaseEndpoint = aws.String(awsConf.STSEndpoint.ValueString())
+			}
+		})
+		awsConfig.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, awsConf.AssumeRoleARN.ValueString(), func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = defaultAWSAssumeRoleSessionName
+			if attributeValued(awsConf.AssumeRoleSessionName) && awsConf.AssumeRoleSessionName.ValueString() != "" {
+				o.RoleSessionName = awsConf.AssumeRoleSessionName.ValueString()
+			}
+		}))
+	}
+
+	return client.NewAWSSigV4Transport(transport, awsConfig.Credentials, awsConfig.Region, service)
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+// isolateAWSEnv prevents the AWS SDK from reading configuration or credentials from the environment running the tests
+func isolateAWSEnv(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	for _, name := range []string{"AWS_REGION", "AWS_DEFAULT_REGION", "AWS_PROFILE", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestUnit_BuildAWSSigV4Transport(t *testing.T) {
+	isolateAWSEnv(t)
+
+	t.Run("static-credentials", func(t *testing.T) {
+		var diags diag.Diagnostics
+		rt := buildAWSSigV4Transport(context.Background(), cleanhttp.DefaultPooledTransport(), nil, OpenSearchProviderConfigAWS{
+			Region:    types.StringValue("us-east-1"),
+			Service:   types.StringValue(client.AWSServiceOpenSearchServerless),
+			AccessKey: types.StringValue("AKIDEXAMPLE"),
+			SecretKey: types.StringValue("secret"),
+		}, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if _, ok := rt.(*client.AWSSigV4Transport); !ok {
+			t.Fatalf("expected *client.AWSSigV4Transport, saw %T", rt)
+		}
+	})
+
+	t.Run("assume-role", func(t *testing.T) {
+		var stsCalls int32
+		sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&stsCalls, 1)
+			_ = r.ParseForm()
+			if r.PostForm.Get("Action") != "AssumeRole" || r.PostForm.Get("RoleSessionName") != defaultAWSAssumeRoleSessionName {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "text/xml")
+			_, _ = io.WriteString(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials>
+<AccessKeyId>ASIAASSUMED</AccessKeyId><SecretAccessKey>assumed-secret</SecretAccessKey><SessionToken>assumed-token</SessionToken>
+<Expiration>`+time.Now().Add(time.Hour).UTC().Format(time.RFC3339)+`</Expiration>
+</Credentials></AssumeRoleResult></AssumeRoleResponse>`)
+		}))
+		t.Cleanup(sts.Close)
+
+		var seen []http.Header
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			seen = append(seen, req.Header.Clone())
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		})
+
+		var proxied int32
+		proxy := func(req *http.Request) (*url.URL, error) {
+			atomic.AddInt32(&proxied, 1)
+			return nil, nil
+		}
+
+		var diags diag.Diagnostics
+		rt := buildAWSSigV4Transport(context.Background(), transport, proxy, OpenSearchProviderConfigAWS{
+			Region:        types.StringValue("us-west-2"),
+			AccessKey:     types.StringValue("AKIDEXAMPLE"),
+			SecretKey:     types.StringValue("secret"),
+			AssumeRoleARN: types.StringValue("arn:aws:iam::123456789012:role/opensearch-admin"),
+			STSEndpoint:   types.StringValue(sts.URL),
+		}, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "https://search-example.us-west-2.es.amazonaws.com/", nil)
+			if _, err := rt.RoundTrip(req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		// sts is called once, not through the opensearch transport, and its credentials are cached
+		if len(seen) != 2 {
+			t.Fatalf("expected 2 requests through the opensearch transport, saw %d", len(seen))
+		}
+		if v := seen[1].Get("Authorization"); !strings.Contains(v, "Credential=ASIAASSUMED/") {
+			t.Errorf("expected request to be signed with assumed role credentials, saw %q", v)
+		}
+		if n := atomic.LoadInt32(&stsCalls); n != 1 {
+			t.Errorf("expected 1 STS call, saw %d", n)
+		}
+		if atomic.LoadInt32(&proxied) == 0 {
+			t.Error("expected STS call to consult the proxy function")
+		}
+	})
+
+	t.Run("invalid-values-throw-errors", func(t *testing.T) {
+		for name, awsConf := range map[string]OpenSearchProviderConfigAWS{
+			"missing-region":     {AccessKey: types.StringValue("AKIDEXAMPLE"), SecretKey: types.StringValue("secret")},
+			"service":            {Region: types.StringValue("us-east-1"), Service: types.StringValue("s3")},
+			"missing-secret-key": {Region: types.StringValue("us-east-1"), AccessKey: types.StringValue("AKIDEXAMPLE")},
+			"missing-profile":    {Region: types.StringValue("us-east-1"), Profile: types.StringValue("nope")},
+		} {
+			var diags diag.Diagnostics
+			if buildAWSSigV4Transport(context.Background(), cleanhttp.DefaultPooledTransport(), nil, awsConf, &diags); !diags.HasError() {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+}
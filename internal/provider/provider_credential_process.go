@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// buildCredentialProcessTransport wraps the provided transport with one that authenticates each request using
+// credentials obtained by running the command described by the provider's credential_process block
+func buildCredentialProcessTransport(transport http.RoundTripper, procConf OpenSearchProviderConfigCredentialProcess, diags *diag.Diagnostics) http.RoundTripper {
+	procPath := path.Root(fields.ConfigAttrCredentialProcess)
+
+	command := conv.StringListToStrings(procConf.Command)
+	if len(command) == 0 || command[0] == "" {
+		diags.AddAttributeError(
+			procPath.AtName(fields.ConfigAttrCommand),
+			"Missing credential process command",
+			fmt.Sprintf("%s must contain the executable to run, followed by its arguments", fields.ConfigAttrCommand),
+		)
+		return nil
+	}
+
+	process := client.CredentialProcess{
+		Command: command,
+		Env:     terraformStringMapToStrings(procConf.Env),
+	}
+
+	if attributeValued(procConf.Timeout) {
+		d, err := time.ParseDuration(procConf.Timeout.ValueString())
+		if err != nil || d <= 0 {
+			diags.AddAttributeError(
+				procPath.AtName(fields.ConfigAttrTimeout),
+				"Invalid credential process timeout",
+				fmt.Sprintf("Value %q of %s must be a positive duration", procConf.Timeout.ValueString(), fields.ConfigAttrTimeout),
+			)
+			return nil
+		}
+		process.Timeout = d
+	}
+
+	return client.NewCredentialProcessTransport(transport, &process)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnit_BuildCredentialProcessTransport(t *testing.T) {
+	command := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("vault-opensearch-creds"), types.StringValue("ci")})
+
+	t.Run("valid", func(t *testing.T) {
+		var diags diag.Diagnostics
+		rt := buildCredentialProcessTransport(cleanhttp.DefaultPooledTransport(), OpenSearchProviderConfigCredentialProcess{
+			Command: command,
+			Env:     types.MapValueMust(types.StringType, map[string]attr.Value{"VAULT_ADDR": types.StringValue("https://vault:8200")}),
+			Timeout: types.StringValue("5s"),
+		}, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if _, ok := rt.(*client.CredentialProcessTransport); !ok {
+			t.Fatalf("expected *client.CredentialProcessTransport, saw %T", rt)
+		}
+	})
+
+	t.Run("invalid-values-throw-errors", func(t *testing.T) {
+		for name, procConf := range map[string]OpenSearchProviderConfigCredentialProcess{
+			"missing-command": {Command: types.ListNull(types.StringType)},
+			"empty-command":   {Command: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("")})},
+			"timeout":         {Command: command, Timeout: types.StringValue("-1s")},
+		} {
+			var diags diag.Diagnostics
+			if buildCredentialProcessTransport(cleanhttp.DefaultPooledTransport(), procConf, &diags); !diags.HasError() {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+}
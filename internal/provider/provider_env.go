@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	providerConfigSourceConfig = "config"
+	providerConfigSourceEnv    = "env"
+	providerConfigSourceUnset  = "unset"
+)
+
+// envDescription appends the name of the environment variable used as a fallback to an attribute description
+func envDescription(desc, env string) string {
+	return fmt.Sprintf("%s.  May also be set with the %s environment variable.", strings.TrimSuffix(desc, "."), env)
+}
+
+// envListDescription appends the name of the environment variable used as a fallback to a list attribute description
+func envListDescription(desc, env string) string {
+	return fmt.Sprintf("%s.  May also be set with the %s environment variable, as a comma-separated list.", strings.TrimSuffix(desc, "."), env)
+}
+
+// envEnabledDescription appends the name of the environment variable used to enable a logger to its description
+func envEnabledDescription(desc, env string) string {
+	return fmt.Sprintf("%s.  May also be enabled by setting the %s environment variable to true.", strings.TrimSuffix(desc, "."), env)
+}
+
+// providerEnvResolver populates provider attributes not set in configuration from their environment variable
+// fallbacks, logging the source each attribute was resolved from.  Values themselves are never logged.
+type providerEnvResolver struct {
+	ctx   context.Context
+	diags *diag.Diagnostics
+}
+
+// lookup returns the value of the named environment variable, if set and not empty
+func (r providerEnvResolver) lookup(attrName, env string, configured bool) (string, bool) {
+	source := providerConfigSourceUnset
+	v, ok := os.LookupEnv(env)
+	v = strings.TrimSpace(v)
+	if configured {
+		source = providerConfigSourceConfig
+		ok = false
+	} else if ok && v != "" {
+		source = providerConfigSourceEnv
+	} else {
+		ok = false
+	}
+	tflog.Debug(r.ctx, "Resolved provider attribute source", map[string]interface{}{
+		"attribute": attrName,
+		"source":    source,
+		"env_var":   env,
+	})
+	return v, ok
+}
+
+func (r providerEnvResolver) invalid(attrName, env, v, expected string, err error) {
+	r.diags.AddError(
+		"Invalid provider environment variable",
+		fmt.Sprintf("Value %q of environment variable %s, used for %s, must be %s: %v", v, env, attrName, expected, err),
+	)
+}
+
+func (r providerEnvResolver) String(dst *types.String, attrName, env string) {
+	if v, ok := r.lookup(attrName, env, !dst.IsNull()); ok {
+		*dst = types.StringValue(v)
+	}
+}
+
+func (r providerEnvResolver) Bool(dst *types.Bool, attrName, env string) {
+	if v, ok := r.lookup(attrName, env, !dst.IsNull()); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			r.invalid(attrName, env, v, "a boolean", err)
+			return
+		}
+		*dst = types.BoolValue(b)
+	}
+}
+
+func (r providerEnvResolver) Int64(dst *types.Int64, attrName, env string) {
+	if v, ok := r.lookup(attrName, env, !dst.IsNull()); ok {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			r.invalid(attrName, env, v, "an integer", err)
+			return
+		}
+		*dst = types.Int64Value(i)
+	}
+}
+
+// StringList reads a comma-separated list of strings
+func (r providerEnvResolver) StringList(dst *types.List, attrName, env string) {
+	if v, ok := r.lookup(attrName, env, !dst.IsNull()); ok {
+		elems := make([]attr.Value, 0)
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				elems = append(elems, types.StringValue(s))
+			}
+		}
+		*dst = types.ListValueMust(types.StringType, elems)
+	}
+}
+
+// Int64List reads a comma-separated list of integers
+func (r providerEnvResolver) Int64List(dst *types.List, attrName, env string) {
+	if v, ok := r.lookup(attrName, env, !dst.IsNull()); ok {
+		elems := make([]attr.Value, 0)
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			i, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				r.invalid(attrName, env, v, "a comma-separated list of integers", err)
+				return
+			}
+			elems = append(elems, types.Int64Value(i))
+		}
+		*dst = types.ListValueMust(types.Int64Type, elems)
+	}
+}
+
+// EnabledObject reads a boolean used to populate the "enabled" attribute of a logger configuration object
+func (r providerEnvResolver) EnabledObject(dst *types.Object, attrTypes map[string]attr.Type, attrName, env string) {
+	if v, ok := r.lookup(attrName, env, !dst.IsNull()); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			r.invalid(attrName, env, v, "a boolean", err)
+			return
+		}
+		attrs := make(map[string]attr.Value, len(attrTypes))
+		for k, t := range attrTypes {
+			switch tt := t.(type) {
+			case types.ListType:
+				attrs[k] = types.ListNull(tt.ElemType)
+			case basetypes.StringType:
+				attrs[k] = types.StringNull()
+			default:
+				attrs[k] = types.BoolNull()
+			}
+		}
+		attrs[fields.ConfigAttrEnabled] = types.BoolValue(b)
+		*dst = types.ObjectValueMust(attrTypes, attrs)
+	}
+}
+
+// applyProviderConfigEnv populates each attribute not set in the provider configuration from its environment
+// variable, if defined.  Configuration always takes precedence.
+func applyProviderConfigEnv(ctx context.Context, conf *OpenSearchProviderConfig, diags *diag.Diagnostics) {
+	r := providerEnvResolver{ctx: ctx, diags: diags}
+
+	r.StringList(&conf.Addresses, fields.ConfigAttrAddresses, fields.EnvAddresses)
+	r.String(&conf.Username, fields.ConfigAttrUsername, fields.EnvUsername)
+	r.String(&conf.Password, fields.ConfigAttrPassword, fields.EnvPassword)
+	r.String(&conf.Token, fields.ConfigAttrToken, fields.EnvToken)
+	r.String(&conf.AuthHeader, fields.ConfigAttrAuthHeader, fields.EnvAuthHeader)
+	r.String(&conf.CACert, fields.ConfigAttrCACert, fields.EnvCACert)
+	r.String(&conf.ClientCert, fields.ConfigAttrClientCert, fields.EnvClientCert)
+	r.String(&conf.ClientKey, fields.ConfigAttrClientKey, fields.EnvClientKey)
+	r.String(&conf.ClientKeyPassword, fields.ConfigAttrClientKeyPassword, fields.EnvClientKeyPassword)
+	r.String(&conf.ProxyURL, fields.ConfigAttrProxyURL, fields.EnvProxyURL)
+	r.StringList(&conf.NoProxy, fields.ConfigAttrNoProxy, fields.EnvNoProxy)
+	r.Int64List(&conf.RetryOnStatus, fields.ConfigAttrRetryOnStatus, fields.EnvRetryOnStatus)
+	r.Bool(&conf.DisableRetry, fields.ConfigAttrDisableRetry, fields.EnvDisableRetry)
+	r.Bool(&conf.EnableRetryOnTimeout, fields.ConfigAttrEnableRetryOnTimeout, fields.EnvEnableRetryOnTimeout)
+	r.Int64(&conf.MaxRetries, fields.ConfigAttrMaxRetries, fields.EnvMaxRetries)
+	r.String(&conf.RetryMinBackoff, fields.ConfigAttrRetryMinBackoff, fields.EnvRetryMinBackoff)
+	r.String(&conf.RetryMaxBackoff, fields.ConfigAttrRetryMaxBackoff, fields.EnvRetryMaxBackoff)
+	r.Bool(&conf.CompressRequestBody, fields.ConfigAttrCompressRequestBody, fields.EnvCompressRequestBody)
+	r.Bool(&conf.InsecureSkipTLSVerify, fields.ConfigAttrInsecureSkipTLSVerify, fields.EnvInsecureSkipTLSVerify)
+	r.Bool(&conf.EnableOnRequestCheck, fields.ConfigAttrEnableOnRequestCheck, fields.EnvEnableOnRequestCheck)
+	r.Bool(&conf.SkipInitProductCheck, fields.ConfigAttrSkipInitProductCheck, fields.EnvSkipInitProductCheck)
+	r.String(&conf.DefaultTimeout, fields.ConfigAttrDefaultTimeout, fields.EnvDefaultTimeout)
+	r.String(&conf.OpaqueIDPrefix, fields.ConfigAttrOpaqueIDPrefix, fields.EnvOpaqueIDPrefix)
+	r.String(&conf.MinimumVersion, fields.ConfigAttrMinimumVersion, fields.EnvMinimumVersion)
+	r.EnabledObject(&conf.ClientDebugLogger, clientDebugLoggerAttrTypeMap, fields.ConfigAttrClientDebugLogger, fields.EnvClientDebugLogger)
+	r.EnabledObject(&conf.RequestTraceLogger, requestTraceLoggerAttrTypeMap, fields.ConfigAttrRequestTraceLogger, fields.EnvRequestTraceLogger)
+}
+
+// applyProviderConfigTLSEnv populates each attribute not set in the provider's tls block from its environment
+// variable, if defined
+func applyProviderConfigTLSEnv(ctx context.Context, tlsConf *OpenSearchProviderConfigTLS, diags *diag.Diagnostics) {
+	r := providerEnvResolver{ctx: ctx, diags: diags}
+
+	attrName := func(name string) string {
+		return fmt.Sprintf("%s.%s", fields.ConfigAttrTLS, name)
+	}
+
+	r.String(&tlsConf.CACertFile, attrName(fields.ConfigAttrCACertFile), fields.EnvTLSCACertFile)
+	r.Bool(&tlsConf.AppendSystemRoots, attrName(fields.ConfigAttrAppendSystemRoots), fields.EnvTLSAppendSystemRoots)
+	r.String(&tlsConf.ServerName, attrName(fields.ConfigAttrServerName), fields.EnvTLSServerName)
+	r.String(&tlsConf.MinVersion, attrName(fields.ConfigAttrMinVersion), fields.EnvTLSMinVersion)
+	r.StringList(&tlsConf.CipherSuites, attrName(fields.ConfigAttrCipherSuites), fields.EnvTLSCipherSuites)
+	r.StringList(&tlsConf.PinnedSPKISHA256, attrName(fields.ConfigAttrPinnedSPKISHA256), fields.EnvTLSPinnedSPKISHA256)
+}
+
+// applyProviderConfigAWSEnv populates each attribute not set in the provider's aws block from its environment
+// variable, if defined.  The standard AWS environment variables are used where one exists.
+func applyProviderConfigAWSEnv(ctx context.Context, awsConf *OpenSearchProviderConfigAWS, diags *diag.Diagnostics) {
+	r := providerEnvResolver{ctx: ctx, diags: diags}
+
+	attrName := func(name string) string {
+		return fmt.Sprintf("%s.%s", fields.ConfigAttrAWS, name)
+	}
+
+	r.String(&awsConf.Region, attrName(fields.ConfigAttrRegion), fields.EnvAWSRegion)
+	r.String(&awsConf.Service, attrName(fields.ConfigAttrService), fields.EnvAWSService)
+	r.String(&awsConf.Profile, attrName(fields.ConfigAttrProfile), fields.EnvAWSProfile)
+	r.String(&awsConf.AccessKey, attrName(fields.ConfigAttrAccessKey), fields.EnvAWSAccessKey)
+	r.String(&awsConf.SecretKey, attrName(fields.ConfigAttrSecretKey), fields.EnvAWSSecretKey)
+	r.String(&awsConf.SessionToken, attrName(fields.ConfigAttrSessionToken), fields.EnvAWSSessionToken)
+	r.String(&awsConf.AssumeRoleARN, attrName(fields.ConfigAttrAssumeRoleARN), fields.EnvAWSAssumeRoleARN)
+	r.String(&awsConf.AssumeRoleSessionName, attrName(fields.ConfigAttrAssumeRoleSessionName), fields.EnvAWSAssumeRoleSessionName)
+	r.String(&awsConf.STSEndpoint, attrName(fields.ConfigAttrSTSEndpoint), fields.EnvAWSSTSEndpoint)
+}
+
+// applyProviderConfigOIDCEnv populates each attribute not set in the provider's oidc block from its environment
+// variable, if defined
+func applyProviderConfigOIDCEnv(ctx context.Context, oidcConf *OpenSearchProviderConfigOIDC, diags *diag.Diagnostics) {
+	r := providerEnvResolver{ctx: ctx, diags: diags}
+
+	attrName := func(name string) string {
+		return fmt.Sprintf("%s.%s", fields.ConfigAttrOIDC, name)
+	}
+
+	r.String(&oidcConf.TokenURL, attrName(fields.ConfigAttrTokenURL), fields.EnvOIDCTokenURL)
+	r.String(&oidcConf.ClientID, attrName(fields.ConfigAttrClientID), fields.EnvOIDCClientID)
+	r.String(&oidcConf.ClientSecret, attrName(fields.ConfigAttrClientSecret), fields.EnvOIDCClientSecret)
+	r.StringList(&oidcConf.Scopes, attrName(fields.ConfigAttrScopes), fields.EnvOIDCScopes)
+	r.String(&oidcConf.Audience, attrName(fields.ConfigAttrAudience), fields.EnvOIDCAudience)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// buildOIDCTransport wraps the provided transport with one that sends an access token, fetched from the token
+// endpoint described by the provider's oidc block, as a bearer token with each request.  The token endpoint is called
+// with authTransport.
+func buildOIDCTransport(transport, authTransport http.RoundTripper, oidcConf OpenSearchProviderConfigOIDC, diags *diag.Diagnostics) http.RoundTripper {
+	oidcPath := path.Root(fields.ConfigAttrOIDC)
+
+	for name, v := range map[string]string{
+		fields.ConfigAttrTokenURL:     oidcConf.TokenURL.ValueString(),
+		fields.ConfigAttrClientID:     oidcConf.ClientID.ValueString(),
+		fields.ConfigAttrClientSecret: oidcConf.ClientSecret.ValueString(),
+	} {
+		if v == "" {
+			diags.AddAttributeError(
+				oidcPath.AtName(name),
+				"Missing OIDC attribute",
+				fmt.Sprintf("%s is required to fetch access tokens", name),
+			)
+		}
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	ccConf := clientcredentials.Config{
+		TokenURL:     oidcConf.TokenURL.ValueString(),
+		ClientID:     oidcConf.ClientID.ValueString(),
+		ClientSecret: oidcConf.ClientSecret.ValueString(),
+		Scopes:       conv.StringListToStrings(oidcConf.Scopes),
+	}
+	// the non-standard "audience" parameter is required by some identity providers
+	if v := oidcConf.Audience.ValueString(); v != "" {
+		ccConf.EndpointParams = url.Values{"audience": {v}}
+	}
+
+	return client.NewBearerTokenTransport(transport, client.NewOIDCClientCredentialsTokenSource(ccConf, authTransport))
+}
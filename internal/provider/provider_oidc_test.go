@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnit_BuildOIDCTransport(t *testing.T) {
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, ok := r.BasicAuth(); !ok || id != "terraform" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-for-%s","token_type":"Bearer","expires_in":3600}`, r.PostForm.Get("scope"))
+	}))
+	t.Cleanup(tokenSrv.Close)
+
+	var seen string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Run("sends-access-token", func(t *testing.T) {
+		var diags diag.Diagnostics
+		rt := buildOIDCTransport(cleanhttp.DefaultPooledTransport(), buildAuthTransport(nil), OpenSearchProviderConfigOIDC{
+			TokenURL:     types.StringValue(tokenSrv.URL),
+			ClientID:     types.StringValue("terraform"),
+			ClientSecret: types.StringValue("secret"),
+			Scopes:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("opensearch")}),
+		}, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if seen != "Bearer token-for-opensearch" {
+			t.Errorf("expected bearer access token, saw %q", seen)
+		}
+	})
+
+	t.Run("token-endpoint-uses-auth-transport", func(t *testing.T) {
+		var opensearchURLs []string
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			opensearchURLs = append(opensearchURLs, req.URL.String())
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		})
+
+		var diags diag.Diagnostics
+		rt := buildOIDCTransport(transport, buildAuthTransport(nil), OpenSearchProviderConfigOIDC{
+			TokenURL:     types.StringValue(tokenSrv.URL),
+			ClientID:     types.StringValue("terraform"),
+			ClientSecret: types.StringValue("secret"),
+		}, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, "https://opensearch.internal:9200/", nil)
+		if _, err := rt.RoundTrip(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(opensearchURLs) != 1 || opensearchURLs[0] != "https://opensearch.internal:9200/" {
+			t.Errorf("expected only the opensearch request through the opensearch transport, saw %v", opensearchURLs)
+		}
+	})
+
+	t.Run("missing-values-throw-errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		buildOIDCTransport(cleanhttp.DefaultPooledTransport(), buildAuthTransport(nil), OpenSearchProviderConfigOIDC{
+			TokenURL: types.StringValue(tokenSrv.URL),
+		}, &diags)
+		if n := diags.ErrorsCount(); n != 2 {
+			t.Errorf("expected 2 errors, saw %d: %v", n, diags)
+		}
+	})
+}
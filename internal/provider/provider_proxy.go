@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// noProxyMatcher determines which hosts are connected to directly, using the same entry formats as the NO_PROXY
+// environment variable: "*", IP addresses, CIDR ranges and domain names, the latter optionally with a port
+type noProxyMatcher struct {
+	all     bool
+	cidrs   []*net.IPNet
+	ips     []net.IP
+	domains []noProxyDomain
+}
+
+type noProxyDomain struct {
+	name string
+	port string
+}
+
+func parseNoProxy(entries []string) noProxyMatcher {
+	var m noProxyMatcher
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			m.all = true
+			continue
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			m.cidrs = append(m.cidrs, cidr)
+			continue
+		}
+		host, port := entry, ""
+		if h, p, err := net.SplitHostPort(entry); err == nil {
+			host, port = h, p
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			m.ips = append(m.ips, ip)
+			continue
+		}
+		host = strings.TrimPrefix(strings.TrimPrefix(host, "*"), ".")
+		m.domains = append(m.domains, noProxyDomain{name: host, port: port})
+	}
+	return m
+}
+
+// match returns true if the request URL should bypass the proxy
+func (m noProxyMatcher) match(u *url.URL) bool {
+	if m.all {
+		return true
+	}
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		for _, cidr := range m.cidrs {
+			if cidr.Contains(ip) {
+				return true
+			}
+		}
+		for _, v := range m.ips {
+			if v.Equal(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, d := range m.domains {
+		if d.port != "" && d.port != port {
+			continue
+		}
+		if host == d.name || strings.HasSuffix(host, "."+d.name) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildProxyFunc returns the function used by the transport to select a proxy for each request.  Without proxy_url,
+// the proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.  Entries in no_proxy are
+// connected to directly in either case.
+func buildProxyFunc(conf OpenSearchProviderConfig, diags *diag.Diagnostics) func(*http.Request) (*url.URL, error) {
+	proxy := http.ProxyFromEnvironment
+
+	if attributeValued(conf.ProxyURL) {
+		proxyURL, err := url.Parse(conf.ProxyURL.ValueString())
+		if err == nil && proxyURL.Host == "" {
+			err = fmt.Errorf("missing host")
+		}
+		if err == nil && !stringsContain(proxySchemes, proxyURL.Scheme) {
+			err = fmt.Errorf("scheme %q must be one of: %s", proxyURL.Scheme, strings.Join(proxySchemes, ", "))
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(fields.ConfigAttrProxyURL),
+				"Invalid proxy URL",
+				fmt.Sprintf("Unable to use %s as a proxy: %v", fields.ConfigAttrProxyURL, err),
+			)
+			return nil
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	noProxy := parseNoProxy(conv.StringListToStrings(conf.NoProxy))
+
+	return func(req *http.Request) (*url.URL, error) {
+		if noProxy.match(req.URL) {
+			return nil, nil
+		}
+		return proxy(req)
+	}
+}
+
+// buildAuthTransport constructs the transport used to call identity providers, such as OIDC token endpoints.  It
+// shares only the proxy of the OpenSearch transport, as the CA certificates, public key pins and client certificate
+// configured for OpenSearch do not apply to these services.
+func buildAuthTransport(proxy func(*http.Request) (*url.URL, error)) *http.Transport {
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.Proxy = proxy
+	return transport
+}
+
+func stringsContain(haystack []string, needle string) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnit_NoProxyMatcher(t *testing.T) {
+	m := parseNoProxy([]string{"10.0.0.0/8", "192.168.1.10", ".internal.example.com", "search.local:9200", " "})
+
+	for raw, expected := range map[string]bool{
+		"https://10.1.2.3:9200":                  true,
+		"https://192.168.1.10:9200":              true,
+		"https://192.168.1.11:9200":              false,
+		"https://internal.example.com":           true,
+		"https://node1.internal.example.com:443": true,
+		"https://example.com":                    false,
+		"http://search.local:9200":               true,
+		"http://search.local":                    false,
+		"https://notsearch.local:9200":           false,
+	} {
+		u, _ := url.Parse(raw)
+		if actual := m.match(u); actual != expected {
+			t.Errorf("%s: expected %t, saw %t", raw, expected, actual)
+		}
+	}
+
+	u, _ := url.Parse("https://anything:9200")
+	if !parseNoProxy([]string{"*"}).match(u) {
+		t.Error("expected * to match all hosts")
+	}
+}
+
+// newSOCKS5TestProxy returns the address of a minimal SOCKS5 proxy requiring username / password authentication.
+// Every CONNECT request is sent to target, regardless of the requested address.
+func newSOCKS5TestProxy(t *testing.T, target string) (string, chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	requested := make(chan string, 1)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				buf := make([]byte, 512)
+
+				// greeting: require username / password authentication
+				if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+					return
+				}
+				if _, err := io.ReadFull(conn, buf[:buf[1]]); err != nil {
+					return
+				}
+				_, _ = conn.Write([]byte{0x05, 0x02})
+
+				// username / password
+				if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+					return
+				}
+				user := make([]byte, buf[1])
+				_, _ = io.ReadFull(conn, user)
+				_, _ = io.ReadFull(conn, buf[:1])
+				pass := make([]byte, buf[0])
+				_, _ = io.ReadFull(conn, pass)
+				if string(user) != "bastion" || string(pass) != "s3cr3t" {
+					_, _ = conn.Write([]byte{0x01, 0x01})
+					return
+				}
+				_, _ = conn.Write([]byte{0x01, 0x00})
+
+				// connect request
+				if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+					return
+				}
+				var host string
+				switch buf[3] {
+				case 0x01:
+					_, _ = io.ReadFull(conn, buf[:4])
+					host = net.IP(buf[:4]).String()
+				case 0x03:
+					_, _ = io.ReadFull(conn, buf[:1])
+					name := make([]byte, buf[0])
+					_, _ = io.ReadFull(conn, name)
+					host = string(name)
+				default:
+					return
+				}
+				_, _ = io.ReadFull(conn, buf[:2])
+				requested <- net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(buf[:2]))))
+
+				upstream, err := net.Dial("tcp", target)
+				if err != nil {
+					_, _ = conn.Write([]byte{0x05, 0x01, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+					return
+				}
+				defer upstream.Close()
+				_, _ = conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+
+				go func() { _, _ = io.Copy(upstream, conn) }()
+				_, _ = io.Copy(conn, upstream)
+			}(conn)
+		}
+	}()
+
+	return l.Addr().String(), requested
+}
+
+func TestUnit_BuildProxyFunc(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(backend.Close)
+
+	build := func(conf OpenSearchProviderConfig) (*http.Transport, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		transport := cleanhttp.DefaultTransport()
+		transport.Proxy = buildProxyFunc(conf, &diags)
+		return transport, diags
+	}
+
+	t.Run("http-proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(proxy.Close)
+
+		transport, diags := build(OpenSearchProviderConfig{ProxyURL: types.StringValue(proxy.URL)})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		resp, err := (&http.Client{Transport: transport}).Get("http://opensearch.internal:9200/_cluster/health")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_ = resp.Body.Close()
+		if proxied != "http://opensearch.internal:9200/_cluster/health" {
+			t.Errorf("expected request to be sent through proxy, saw %q", proxied)
+		}
+	})
+
+	t.Run("socks5-proxy-with-auth", func(t *testing.T) {
+		addr, requested := newSOCKS5TestProxy(t, backend.Listener.Addr().String())
+
+		transport, diags := build(OpenSearchProviderConfig{ProxyURL: types.StringValue("socks5://bastion:s3cr3t@" + addr)})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		resp, err := (&http.Client{Transport: transport}).Get("http://opensearch.internal:9200/")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status %d, saw %d", http.StatusOK, resp.StatusCode)
+		}
+		if v := <-requested; v != "opensearch.internal:9200" {
+			t.Errorf("expected proxy to resolve %q, saw %q", "opensearch.internal:9200", v)
+		}
+	})
+
+	t.Run("no-proxy", func(t *testing.T) {
+		transport, diags := build(OpenSearchProviderConfig{
+			ProxyURL: types.StringValue("socks5://127.0.0.1:1080"),
+			NoProxy:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("127.0.0.0/8")}),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		resp, err := (&http.Client{Transport: transport}).Get(backend.URL)
+		if err != nil {
+			t.Fatalf("expected direct connection, saw error: %v", err)
+		}
+		_ = resp.Body.Close()
+	})
+
+	t.Run("invalid-values-throw-errors", func(t *testing.T) {
+		for _, v := range []string{"ftp://proxy:21", "socks5://", "://nope"} {
+			if _, diags := build(OpenSearchProviderConfig{ProxyURL: types.StringValue(v)}); !diags.HasError() {
+				t.Errorf("%s: expected error", v)
+			}
+		}
+	})
+}
+
+func TestUnit_BuildAuthTransport(t *testing.T) {
+	var diags diag.Diagnostics
+	conf := OpenSearchProviderConfig{
+		InsecureSkipTLSVerify: types.BoolValue(true),
+		ProxyURL:              types.StringValue("http://proxy.internal:3128"),
+	}
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = buildTLSConfig(context.Background(), conf, OpenSearchProviderConfigTLS{}, &diags)
+	transport.Proxy = buildProxyFunc(conf, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	auth := buildAuthTransport(transport.Proxy)
+	if auth.TLSClientConfig != nil && auth.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected auth transport not to inherit the OpenSearch TLS configuration")
+	}
+	req, _ := http.NewRequest(http.MethodPost, "https://idp.internal/oauth2/token", nil)
+	if u, err := auth.Proxy(req); err != nil || u == nil || u.Host != "proxy.internal:3128" {
+		t.Errorf("expected auth transport to use the configured proxy, saw %v: %v", u, err)
+	}
+}
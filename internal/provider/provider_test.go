@@ -1,13 +1,27 @@
 package provider
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
 	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
 	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opensearch-project/opensearch-go"
 )
 
 var providerFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -20,10 +34,51 @@ func testAccPreCheck(t *testing.T) {
 	// function.
 }
 
+// testAccClient constructs a client against the same cluster used by acctest.ProviderConfigLocalhostWith, for use
+// in making changes outside of terraform
+func testAccClient(t *testing.T) *opensearch.Client {
+	t.Helper()
+	osClient, err := opensearch.NewClient(opensearch.Config{
+		Addresses: []string{"https://127.0.0.1:9200"},
+		Username:  os.Getenv("OPENSEARCH_USERNAME"),
+		Password:  os.Getenv("OPENSEARCH_PASSWORD"),
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error constructing test client: %v", err)
+	}
+	return osClient
+}
+
 func TestBuild_Provider(t *testing.T) {
 	_ = NewOpenSearchProvider("test")
 }
 
+func TestUnit_ProviderClose(t *testing.T) {
+	p := New("test")
+	hl, err := client.NewHARLogger(context.Background(), filepath.Join(t.TempDir(), "opensearch.har"), "test", client.NewRedactor(nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.harLoggers = append(p.harLoggers, hl)
+
+	if err = p.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.harLoggers) != 0 {
+		t.Errorf("expected HAR loggers to be released, saw %d", len(p.harLoggers))
+	}
+	if err = hl.Close(); err != nil {
+		t.Errorf("expected HAR logger to already be closed, saw %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://localhost:9200/", nil)
+	if err = hl.LogRoundTrip(req, nil, nil, time.Now(), time.Millisecond); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("expected %v after close, saw %v", os.ErrClosed, err)
+	}
+}
+
 func TestUnit_ProviderConfig(t *testing.T) {
 	t.Run("env", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
@@ -75,3 +130,144 @@ func TestUnit_ProviderConfig(t *testing.T) {
 		})
 	})
 }
+
+func TestUnit_ProviderConfigEnv(t *testing.T) {
+	t.Run("config-takes-precedence", func(t *testing.T) {
+		t.Setenv(fields.EnvUsername, "env-user")
+		t.Setenv(fields.EnvPassword, "env-pass")
+
+		var diags diag.Diagnostics
+		conf := OpenSearchProviderConfig{
+			Username:           types.StringValue("config-user"),
+			ClientDebugLogger:  types.ObjectNull(clientDebugLoggerAttrTypeMap),
+			RequestTraceLogger: types.ObjectNull(requestTraceLoggerAttrTypeMap),
+		}
+		applyProviderConfigEnv(context.Background(), &conf, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if v := conf.Username.ValueString(); v != "config-user" {
+			t.Errorf("expected username %q, saw %q", "config-user", v)
+		}
+		if v := conf.Password.ValueString(); v != "env-pass" {
+			t.Errorf("expected password %q, saw %q", "env-pass", v)
+		}
+	})
+
+	t.Run("parses-values", func(t *testing.T) {
+		t.Setenv(fields.EnvAddresses, "https://node1:9200, https://node2:9200")
+		t.Setenv(fields.EnvRetryOnStatus, "500,502")
+		t.Setenv(fields.EnvInsecureSkipTLSVerify, "true")
+		t.Setenv(fields.EnvMaxRetries, "7")
+		t.Setenv(fields.EnvRequestTraceLogger, "1")
+
+		var diags diag.Diagnostics
+		conf := OpenSearchProviderConfig{
+			ClientDebugLogger:  types.ObjectNull(clientDebugLoggerAttrTypeMap),
+			RequestTraceLogger: types.ObjectNull(requestTraceLoggerAttrTypeMap),
+		}
+		applyProviderConfigEnv(context.Background(), &conf, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if v := conv.StringListToStrings(conf.Addresses); len(v) != 2 || v[0] != "https://node1:9200" || v[1] != "https://node2:9200" {
+			t.Errorf("unexpected addresses: %v", v)
+		}
+		if v := conv.Int64ListToInts(conf.RetryOnStatus); len(v) != 2 || v[0] != 500 || v[1] != 502 {
+			t.Errorf("unexpected retry_on_status: %v", v)
+		}
+		if !conf.InsecureSkipTLSVerify.ValueBool() {
+			t.Error("expected insecure_skip_tls_verify to be true")
+		}
+		if v := conf.MaxRetries.ValueInt64(); v != 7 {
+			t.Errorf("expected max_retries 7, saw %d", v)
+		}
+		var traceLogConf OpenSearchProviderConfigRequestTraceLogger
+		diags.Append(conf.RequestTraceLogger.As(context.Background(), &traceLogConf, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if !traceLogConf.Enabled.ValueBool() {
+			t.Error("expected request_trace_logger to be enabled")
+		}
+		if !conf.ClientDebugLogger.IsNull() {
+			t.Error("expected client_debug_logger to remain null")
+		}
+	})
+
+	t.Run("invalid-value-throws-error", func(t *testing.T) {
+		t.Setenv(fields.EnvDisableRetry, "sometimes")
+
+		var diags diag.Diagnostics
+		conf := OpenSearchProviderConfig{
+			ClientDebugLogger:  types.ObjectNull(clientDebugLoggerAttrTypeMap),
+			RequestTraceLogger: types.ObjectNull(requestTraceLoggerAttrTypeMap),
+		}
+		applyProviderConfigEnv(context.Background(), &conf, &diags)
+		if !diags.HasError() {
+			t.Fatal("expected error")
+		}
+		if d := diags.Errors()[0].Detail(); !strings.Contains(d, fields.EnvDisableRetry) {
+			t.Errorf("expected error to reference %s, saw %q", fields.EnvDisableRetry, d)
+		}
+	})
+}
+
+func TestUnit_BuildRetryPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		maxRetries types.Int64
+		expected   int
+	}{
+		"unset":    {maxRetries: types.Int64Null(), expected: client.DefaultRetryMaxRetries},
+		"zero":     {maxRetries: types.Int64Value(0), expected: 0},
+		"explicit": {maxRetries: types.Int64Value(7), expected: 7},
+	} {
+		var diags diag.Diagnostics
+		policy := buildRetryPolicy(OpenSearchProviderConfig{MaxRetries: tc.maxRetries}, &diags)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected errors: %v", name, diags)
+		}
+		if policy.MaxRetries != tc.expected {
+			t.Errorf("%s: expected max retries %d, saw %d", name, tc.expected, policy.MaxRetries)
+		}
+	}
+}
+
+func TestUnit_OpaqueID(t *testing.T) {
+	build := func(conf OpenSearchProviderConfig) (string, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		return buildOpaqueIDPrefix(conf, "1.0.0", &diags), diags
+	}
+
+	t.Run("default-prefix", func(t *testing.T) {
+		prefix, diags := build(OpenSearchProviderConfig{})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		r := NewPluginSecurityRoleResource().(*PluginSecurityRoleResource)
+		r.opaqueIDPrefix = prefix
+		ctx := r.operationContext(context.Background(), fields.ResourceAttrCreate)
+		if v := client.OpaqueIDFromContext(ctx); v != "terraform/1.0.0/opensearch_security_plugin_role/create" {
+			t.Errorf("unexpected opaque id: %q", v)
+		}
+	})
+
+	t.Run("custom-prefix", func(t *testing.T) {
+		prefix, diags := build(OpenSearchProviderConfig{OpaqueIDPrefix: types.StringValue("ci/deploy-42/")})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		d := NewPluginSecurityRolesDataSource().(*PluginSecurityRolesDataSource)
+		d.opaqueIDPrefix = prefix
+		ctx := d.operationContext(context.Background(), fields.ResourceAttrRead)
+		if v := client.OpaqueIDFromContext(ctx); v != "ci/deploy-42/1.0.0/opensearch_security_plugin_roles/read" {
+			t.Errorf("unexpected opaque id: %q", v)
+		}
+	})
+
+	t.Run("invalid-prefix-throws-error", func(t *testing.T) {
+		if _, diags := build(OpenSearchProviderConfig{OpaqueIDPrefix: types.StringValue("terraform\nX-Injected: 1")}); !diags.HasError() {
+			t.Fatal("expected error")
+		}
+	})
+}
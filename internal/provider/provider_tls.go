@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/youmark/pkcs8"
+)
+
+const (
+	pemBoundary = "-----BEGIN "
+
+	spkiPinPrefix = "sha256//"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+var tlsVersionNames = []string{"1.0", "1.1", "1.2", "1.3"}
+
+// readPEMValue returns the provided value as-is if it contains PEM encoded data, otherwise the value is treated as
+// the path to a file containing PEM encoded data
+func readPEMValue(v string) ([]byte, error) {
+	if strings.Contains(v, pemBoundary) {
+		return []byte(v), nil
+	}
+	b, err := os.ReadFile(strings.TrimSpace(v))
+	if err != nil {
+		return nil, fmt.Errorf("value is neither PEM encoded data nor a readable file: %w", err)
+	}
+	return b, nil
+}
+
+// decryptPEMKey decrypts an encrypted PKCS #8 PEM private key, returning the PEM encoded plaintext key.  Unencrypted
+// keys are returned as-is.  Legacy RFC 1423 PEM encryption is insecure by design and is rejected.
+func decryptPEMKey(keyPEM []byte, password string) ([]byte, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+	if strings.Contains(block.Headers["Proc-Type"], "ENCRYPTED") {
+		return nil, errors.New("private keys using legacy PEM encryption are not supported, please convert the key to an encrypted PKCS #8 key, e.g. with \"openssl pkcs8 -topk8 -v2 aes-256-cbc\"")
+	}
+	if block.Type != "ENCRYPTED PRIVATE KEY" {
+		return keyPEM, nil
+	}
+	if password == "" {
+		return nil, fmt.Errorf("private key is encrypted, but no %s was provided", fields.ConfigAttrClientKeyPassword)
+	}
+	key, err := pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(password))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting private key: %w", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding decrypted private key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// loadClientCertificate constructs a certificate for TLS client authentication.  Both the certificate and key may be
+// either PEM encoded data or a path to a file containing PEM encoded data.  An encrypted PKCS #8 key is decrypted with
+// the provided password.
+func loadClientCertificate(cert, key, password string) (tls.Certificate, error) {
+	certPEM, err := readPEMValue(cert)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error reading client certificate: %w", err)
+	}
+	keyPEM, err := readPEMValue(key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error reading client key: %w", err)
+	}
+	if keyPEM, err = decryptPEMKey(keyPEM, password); err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// cipherSuiteID returns the ID of the named cipher suite, including those considered insecure
+func cipherSuiteID(name string) (uint16, bool) {
+	for _, cs := range tls.CipherSuites() {
+		if cs.Name == name {
+			return cs.ID, true
+		}
+	}
+	for _, cs := range tls.InsecureCipherSuites() {
+		if cs.Name == name {
+			return cs.ID, true
+		}
+	}
+	return 0, false
+}
+
+// parseSPKIPin decodes a base64 encoded SHA-256 hash of a certificate's Subject Public Key Info, optionally prefixed
+// with "sha256//" as accepted by curl
+func parseSPKIPin(pin string) ([]byte, error) {
+	pin = strings.TrimPrefix(strings.TrimSpace(pin), spkiPinPrefix)
+	b, err := base64.StdEncoding.DecodeString(pin)
+	if err != nil {
+		return nil, fmt.Errorf("pin is not valid base64: %w", err)
+	}
+	if len(b) != sha256.Size {
+		return nil, fmt.Errorf("pin must be a %d byte SHA-256 hash, saw %d bytes", sha256.Size, len(b))
+	}
+	return b, nil
+}
+
+// verifySPKIPins returns a function that verifies the server's certificate chain matches one of the provided pins.
+// When the chain was verified, any certificate in a verified chain may match.  Otherwise only the leaf certificate is
+// checked, as the remaining certificates presented by the server are unauthenticated and may be chosen by an attacker.
+func verifySPKIPins(pins [][]byte) func(tls.ConnectionState) error {
+	matches := func(cert *x509.Certificate) bool {
+		sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+		for _, pin := range pins {
+			if bytes.Equal(sum[:], pin) {
+				return true
+			}
+		}
+		return false
+	}
+	return func(cs tls.ConnectionState) error {
+		if len(cs.VerifiedChains) > 0 {
+			for _, chain := range cs.VerifiedChains {
+				for _, cert := range chain {
+					if matches(cert) {
+						return nil
+					}
+				}
+			}
+		} else if len(cs.PeerCertificates) > 0 && matches(cs.PeerCertificates[0]) {
+			return nil
+		}
+		return errors.New("the certificate presented by the server does not match a pinned public key")
+	}
+}
+
+// buildTLSConfig constructs the TLS configuration used by the provider's transport
+func buildTLSConfig(ctx context.Context, conf OpenSearchProviderConfig, tlsConf OpenSearchProviderConfigTLS, diags *diag.Diagnostics) *tls.Config {
+	var (
+		tlsConfig = &tls.Config{}
+		tlsPath   = path.Root(fields.ConfigAttrTLS)
+	)
+
+	tlsConfig.InsecureSkipVerify = conf.InsecureSkipTLSVerify.ValueBool()
+
+	// did they provide ca's?
+	if attributeValued(conf.CACert) || attributeValued(tlsConf.CACertFile) {
+		var rootCAs *x509.CertPool
+		if tlsConf.AppendSystemRoots.ValueBool() {
+			var err error
+			if rootCAs, err = x509.SystemCertPool(); err != nil {
+				tflog.Warn(ctx, "Unable to load system certificate pool, only the provided certificate authorities will be trusted", map[string]interface{}{"err": err.Error()})
+				rootCAs = nil
+			}
+		}
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if attributeValued(conf.CACert) && !rootCAs.AppendCertsFromPEM([]byte(conf.CACert.ValueString())) {
+			diags.AddAttributeError(
+				path.Root(fields.ConfigAttrCACert),
+				"Invalid CA certificate",
+				"No PEM encoded certificates could be parsed from the provided value",
+			)
+			return nil
+		}
+		if attributeValued(tlsConf.CACertFile) {
+			b, err := os.ReadFile(tlsConf.CACertFile.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					tlsPath.AtName(fields.ConfigAttrCACertFile),
+					"Invalid CA certificate file",
+					fmt.Sprintf("Error reading CA certificate file: %v", err),
+				)
+				return nil
+			}
+			if !rootCAs.AppendCertsFromPEM(b) {
+				diags.AddAttributeError(
+					tlsPath.AtName(fields.ConfigAttrCACertFile),
+					"Invalid CA certificate file",
+					fmt.Sprintf("No PEM encoded certificates could be parsed from %q", tlsConf.CACertFile.ValueString()),
+				)
+				return nil
+			}
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	// did they provide a client certificate?
+	if attributeValued(conf.ClientCert) || attributeValued(conf.ClientKey) {
+		if !attributeValued(conf.ClientCert) || !attributeValued(conf.ClientKey) {
+			diags.AddError(
+				"Incomplete client certificate configuration",
+				fmt.Sprintf("Both %s and %s must be set to use TLS client authentication", fields.ConfigAttrClientCert, fields.ConfigAttrClientKey),
+			)
+			return nil
+		}
+		clientCert, err := loadClientCertificate(conf.ClientCert.ValueString(), conf.ClientKey.ValueString(), conf.ClientKeyPassword.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(fields.ConfigAttrClientCert),
+				"Invalid client certificate",
+				fmt.Sprintf("Error loading client certificate: %v", err),
+			)
+			return nil
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	if attributeValued(tlsConf.ServerName) {
+		tlsConfig.ServerName = tlsConf.ServerName.ValueString()
+	}
+
+	if attributeValued(tlsConf.MinVersion) {
+		v, ok := tlsVersions[tlsConf.MinVersion.ValueString()]
+		if !ok {
+			diags.AddAttributeError(
+				tlsPath.AtName(fields.ConfigAttrMinVersion),
+				"Invalid TLS version",
+				fmt.Sprintf("Minimum TLS version %q must be one of: %s", tlsConf.MinVersion.ValueString(), strings.Join(tlsVersionNames, ", ")),
+			)
+			return nil
+		}
+		tlsConfig.MinVersion = v
+	}
+
+	if attributeValued(tlsConf.CipherSuites) {
+		for _, name := range conv.StringListToStrings(tlsConf.CipherSuites) {
+			id, ok := cipherSuiteID(name)
+			if !ok {
+				diags.AddAttributeError(
+					tlsPath.AtName(fields.ConfigAttrCipherSuites),
+					"Invalid cipher suite",
+					fmt.Sprintf("Cipher suite %q is not supported", name),
+				)
+				return nil
+			}
+			tlsConfig.CipherSuites = append(tlsConfig.CipherSuites, id)
+		}
+	}
+
+	if attributeValued(tlsConf.PinnedSPKISHA256) {
+		var pins [][]byte
+		for _, v := range conv.StringListToStrings(tlsConf.PinnedSPKISHA256) {
+			pin, err := parseSPKIPin(v)
+			if err != nil {
+				diags.AddAttributeError(
+					tlsPath.AtName(fields.ConfigAttrPinnedSPKISHA256),
+					"Invalid public key pin",
+					fmt.Sprintf("Public key pin %q is invalid: %v", v, err),
+				)
+				return nil
+			}
+			pins = append(pins, pin)
+		}
+		if len(pins) > 0 {
+			tlsConfig.VerifyConnection = verifySPKIPins(pins)
+		}
+	}
+
+	return tlsConfig
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/youmark/pkcs8"
+)
+
+// testClientCertificatePEM generates a self-signed certificate and matching private key
+func testClientCertificatePEM(t *testing.T) ([]byte, []byte, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error creating certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("error marshalling key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		key
+}
+
+func TestUnit_LoadClientCertificate(t *testing.T) {
+	certPEM, keyPEM, key := testClientCertificatePEM(t)
+
+	t.Run("pem-content", func(t *testing.T) {
+		cert, err := loadClientCertificate(string(certPEM), string(keyPEM), "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cert.Certificate) != 1 {
+			t.Errorf("expected 1 certificate, saw %d", len(cert.Certificate))
+		}
+	})
+
+	t.Run("file-paths", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile := filepath.Join(dir, "admin.pem")
+		keyFile := filepath.Join(dir, "admin-key.pem")
+		if err := os.WriteFile(certFile, certPEM, 0600); err != nil {
+			t.Fatalf("error writing cert: %v", err)
+		}
+		if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+			t.Fatalf("error writing key: %v", err)
+		}
+		if _, err := loadClientCertificate(certFile, keyFile, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("encrypted-key", func(t *testing.T) {
+		der, err := pkcs8.MarshalPrivateKey(key, []byte("hunter2"), nil)
+		if err != nil {
+			t.Fatalf("error encrypting key: %v", err)
+		}
+		encPEM := string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}))
+
+		if _, err := loadClientCertificate(string(certPEM), encPEM, "hunter2"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := loadClientCertificate(string(certPEM), encPEM, "wrong"); err == nil {
+			t.Fatal("expected error with incorrect password")
+		}
+		if _, err := loadClientCertificate(string(certPEM), encPEM, ""); err == nil {
+			t.Fatal("expected error without password")
+		}
+	})
+
+	t.Run("legacy-encrypted-key-throws-error", func(t *testing.T) {
+		keyDER, _ := x509.MarshalECPrivateKey(key)
+		legacyPEM := string(pem.EncodeToMemory(&pem.Block{
+			Type:    "EC PRIVATE KEY",
+			Headers: map[string]string{"Proc-Type": "4,ENCRYPTED", "DEK-Info": "AES-256-CBC,00000000000000000000000000000000"},
+			Bytes:   keyDER,
+		}))
+		_, err := loadClientCertificate(string(certPEM), legacyPEM, "hunter2")
+		if err == nil || !strings.Contains(err.Error(), "legacy PEM encryption") {
+			t.Fatalf("expected legacy PEM encryption error, saw %v", err)
+		}
+	})
+
+	t.Run("missing-file-throws-error", func(t *testing.T) {
+		if _, err := loadClientCertificate(filepath.Join(t.TempDir(), "nope.pem"), string(keyPEM), ""); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func testTLSConfigRequest(t *testing.T, srv *httptest.Server, tlsConfig *tls.Config) error {
+	t.Helper()
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = tlsConfig
+	defer transport.CloseIdleConnections()
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+func TestUnit_BuildTLSConfig(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	srvCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	srvSPKI := sha256.Sum256(srv.Certificate().RawSubjectPublicKeyInfo)
+	srvPin := base64.StdEncoding.EncodeToString(srvSPKI[:])
+
+	build := func(conf OpenSearchProviderConfig, tlsConf OpenSearchProviderConfigTLS) (*tls.Config, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		return buildTLSConfig(context.Background(), conf, tlsConf, &diags), diags
+	}
+
+	t.Run("ca-cert-file-and-server-name", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		if err := os.WriteFile(caFile, srvCertPEM, 0600); err != nil {
+			t.Fatalf("error writing ca: %v", err)
+		}
+		tlsConfig, diags := build(OpenSearchProviderConfig{}, OpenSearchProviderConfigTLS{
+			CACertFile: types.StringValue(caFile),
+			ServerName: types.StringValue("example.com"),
+			MinVersion: types.StringValue("1.2"),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if tlsConfig.MinVersion != tls.VersionTLS12 {
+			t.Errorf("expected min version %d, saw %d", tls.VersionTLS12, tlsConfig.MinVersion)
+		}
+		if err := testTLSConfigRequest(t, srv, tlsConfig); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// the test server certificate is not valid for this name
+		tlsConfig.ServerName = "opensearch.invalid"
+		if err := testTLSConfigRequest(t, srv, tlsConfig); err == nil {
+			t.Fatal("expected hostname verification error")
+		}
+	})
+
+	t.Run("append-system-roots", func(t *testing.T) {
+		tlsConfig, diags := build(OpenSearchProviderConfig{CACert: types.StringValue(string(srvCertPEM))}, OpenSearchProviderConfigTLS{
+			AppendSystemRoots: types.BoolValue(true),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if tlsConfig.RootCAs == nil {
+			t.Fatal("expected root CAs to be set")
+		}
+	})
+
+	t.Run("spki-pin", func(t *testing.T) {
+		tlsConfig, diags := build(OpenSearchProviderConfig{InsecureSkipTLSVerify: types.BoolValue(true)}, OpenSearchProviderConfigTLS{
+			PinnedSPKISHA256: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sha256//" + srvPin)}),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if err := testTLSConfigRequest(t, srv, tlsConfig); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("spki-pin-mismatch-throws-error", func(t *testing.T) {
+		otherPin := sha256.Sum256([]byte("nope"))
+		tlsConfig, diags := build(OpenSearchProviderConfig{InsecureSkipTLSVerify: types.BoolValue(true)}, OpenSearchProviderConfigTLS{
+			PinnedSPKISHA256: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(base64.StdEncoding.EncodeToString(otherPin[:]))}),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if err := testTLSConfigRequest(t, srv, tlsConfig); err == nil {
+			t.Fatal("expected pin verification error")
+		}
+	})
+
+	t.Run("spki-pin-appended-after-leaf-throws-error", func(t *testing.T) {
+		// the server presents its own leaf followed by an unrelated, pinned certificate
+		pinnedCertPEM, _, _ := testClientCertificatePEM(t)
+		pinnedBlock, _ := pem.Decode(pinnedCertPEM)
+		pinnedCert, err := x509.ParseCertificate(pinnedBlock.Bytes)
+		if err != nil {
+			t.Fatalf("error parsing certificate: %v", err)
+		}
+		pinnedSPKI := sha256.Sum256(pinnedCert.RawSubjectPublicKeyInfo)
+
+		leaf := srv.TLS.Certificates[0]
+		mitm := httptest.NewUnstartedServer(srv.Config.Handler)
+		mitm.TLS = &tls.Config{Certificates: []tls.Certificate{{
+			Certificate: append(append([][]byte{}, leaf.Certificate...), pinnedBlock.Bytes),
+			PrivateKey:  leaf.PrivateKey,
+		}}}
+		mitm.StartTLS()
+		t.Cleanup(mitm.Close)
+
+		tlsConfig, diags := build(OpenSearchProviderConfig{InsecureSkipTLSVerify: types.BoolValue(true)}, OpenSearchProviderConfigTLS{
+			PinnedSPKISHA256: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(base64.StdEncoding.EncodeToString(pinnedSPKI[:]))}),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if err := testTLSConfigRequest(t, mitm, tlsConfig); err == nil {
+			t.Fatal("expected pin verification error")
+		}
+	})
+
+	t.Run("spki-pin-verified-chain", func(t *testing.T) {
+		tlsConfig, diags := build(OpenSearchProviderConfig{CACert: types.StringValue(string(srvCertPEM))}, OpenSearchProviderConfigTLS{
+			ServerName:       types.StringValue("example.com"),
+			PinnedSPKISHA256: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(srvPin)}),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if err := testTLSConfigRequest(t, srv, tlsConfig); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("cipher-suites", func(t *testing.T) {
+		tlsConfig, diags := build(OpenSearchProviderConfig{}, OpenSearchProviderConfigTLS{
+			CipherSuites: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384")}),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if len(tlsConfig.CipherSuites) != 1 || tlsConfig.CipherSuites[0] != tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384 {
+			t.Errorf("unexpected cipher suites: %v", tlsConfig.CipherSuites)
+		}
+	})
+
+	t.Run("invalid-values-throw-errors", func(t *testing.T) {
+		for name, tlsConf := range map[string]OpenSearchProviderConfigTLS{
+			"cipher-suite": {CipherSuites: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TLS_NOPE")})},
+			"pin":          {PinnedSPKISHA256: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("bm9wZQ==")})},
+			"ca-cert-file": {CACertFile: types.StringValue(filepath.Join(t.TempDir(), "nope.pem"))},
+			"min-version":  {MinVersion: types.StringValue("2.0")},
+		} {
+			if _, diags := build(OpenSearchProviderConfig{}, tlsConf); !diags.HasError() {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+}
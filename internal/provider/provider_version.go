@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/opensearch-project/opensearch-go"
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+var (
+	// minimumPluginSecurityVersion is the first release serving the security plugin REST API beneath _plugins, which
+	// every security plugin type uses
+	minimumPluginSecurityVersion = client.Version{Major: 1}
+
+	// minimumPluginSecurityAllowlistVersion is the first release serving the security plugin allowlist API, which
+	// replaced the whitelist API of 1.x releases
+	minimumPluginSecurityAllowlistVersion = client.Version{Major: 2}
+)
+
+// parseMinimumVersion parses the optional minimum_version provider attribute, returning the zero version if it was
+// not set
+func parseMinimumVersion(conf OpenSearchProviderConfig, diags *diag.Diagnostics) client.Version {
+	if !attributeValued(conf.MinimumVersion) {
+		return client.Version{}
+	}
+	v, err := client.ParseVersion(conf.MinimumVersion.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(fields.ConfigAttrMinimumVersion),
+			"Invalid minimum version",
+			fmt.Sprintf("Unable to parse %s: %v", fields.ConfigAttrMinimumVersion, err),
+		)
+	}
+	return v
+}
+
+// checkClusterInfo fetches the distribution and version of the cluster, failing if it is not OpenSearch or is older
+// than the minimum version
+func checkClusterInfo(ctx context.Context, osClient *opensearch.Client, header http.Header, minimum client.Version, diags *diag.Diagnostics) (client.ClusterInfo, client.Version) {
+	var info client.ClusterInfo
+
+	infoReq := opensearchapi.InfoRequest{Header: header}
+	infoResp, err := infoReq.Do(ctx, osClient)
+	if err == nil {
+		err = client.ParseResponse(infoResp, &info, http.StatusOK)
+	}
+	if err != nil {
+		if m, ok := err.(*client.APIStatusResponse); ok {
+			m.AppendDiagnostics(diags)
+		} else {
+			diags.AddError(
+				"Error performing init compatibility check",
+				fmt.Sprintf("Error occurred during init compatibility check: %v", err),
+			)
+		}
+		return info, client.Version{}
+	}
+
+	if dist := info.Distribution(); dist != client.DistributionOpenSearch {
+		diags.AddError(
+			"Unsupported distribution",
+			fmt.Sprintf(
+				"The cluster reports distribution %q version %q, but this provider only supports OpenSearch.",
+				dist,
+				info.Version.Number,
+			),
+		)
+		return info, client.Version{}
+	}
+
+	version, err := client.ParseVersion(info.Version.Number)
+	if err != nil {
+		diags.AddError(
+			"Error performing init compatibility check",
+			fmt.Sprintf("Unable to parse the version reported by the cluster: %v", err),
+		)
+		return info, client.Version{}
+	}
+
+	if version.LessThan(minimum) {
+		diags.AddAttributeError(
+			path.Root(fields.ConfigAttrMinimumVersion),
+			"Unsupported OpenSearch version",
+			fmt.Sprintf("The cluster is running OpenSearch %s, older than the configured minimum version %s.", version, minimum),
+		)
+	}
+
+	return info, version
+}
+
+// checkMinimumVersion adds an error if the cluster is known to be older than the minimum version required by the
+// named resource or data source type.  Nothing is checked when the cluster version is unknown.
+func checkMinimumVersion(typeName string, required, cluster client.Version, diags *diag.Diagnostics) {
+	if cluster.IsZero() || !cluster.LessThan(required) {
+		return
+	}
+	diags.AddError(
+		"Unsupported OpenSearch version",
+		fmt.Sprintf("%s requires OpenSearch %s or later, but the cluster is running OpenSearch %s.", typeName, required, cluster),
+	)
+}
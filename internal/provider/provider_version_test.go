@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/opensearch-project/opensearch-go"
+)
+
+func TestUnit_ParseMinimumVersion(t *testing.T) {
+	var diags diag.Diagnostics
+	if v := parseMinimumVersion(OpenSearchProviderConfig{MinimumVersion: types.StringNull()}, &diags); !v.IsZero() || diags.HasError() {
+		t.Errorf("expected zero version without errors, saw %s: %v", v, diags)
+	}
+	if v := parseMinimumVersion(OpenSearchProviderConfig{MinimumVersion: types.StringValue("2.5")}, &diags); v != (client.Version{Major: 2, Minor: 5}) || diags.HasError() {
+		t.Errorf("expected 2.5.0 without errors, saw %s: %v", v, diags)
+	}
+	if parseMinimumVersion(OpenSearchProviderConfig{MinimumVersion: types.StringValue("latest")}, &diags); !diags.HasError() {
+		t.Error("expected error")
+	}
+}
+
+func TestUnit_CheckClusterInfo(t *testing.T) {
+	const (
+		openSearchInfo    = `{"name":"node-1","cluster_name":"docker-cluster","version":{"distribution":"opensearch","number":"2.11.0"},"tagline":"The OpenSearch Project: https://opensearch.org/"}`
+		elasticsearchInfo = `{"name":"node-1","cluster_name":"docker-cluster","version":{"number":"8.11.1","build_flavor":"default"},"tagline":"You Know, for Search"}`
+	)
+
+	check := func(t *testing.T, body string, minimum client.Version) (client.ClusterInfo, client.Version, http.Header, diag.Diagnostics) {
+		t.Helper()
+		var seen http.Header
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen = r.Header.Clone()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(srv.Close)
+
+		osClient, err := opensearch.NewClient(opensearch.Config{
+			Addresses:            []string{srv.URL},
+			UseResponseCheckOnly: true,
+		})
+		if err != nil {
+			t.Fatalf("error constructing client: %v", err)
+		}
+
+		var diags diag.Diagnostics
+		header := http.Header{client.HeaderOpaqueID: {"terraform/test/opensearch/configure"}}
+		info, version := checkClusterInfo(context.Background(), osClient, header, minimum, &diags)
+		return info, version, seen, diags
+	}
+
+	t.Run("opensearch", func(t *testing.T) {
+		info, version, seen, diags := check(t, openSearchInfo, client.Version{Major: 2, Minor: 5})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if info.Distribution() != client.DistributionOpenSearch || version != (client.Version{Major: 2, Minor: 11}) {
+			t.Errorf("unexpected distribution %q and version %s", info.Distribution(), version)
+		}
+		if v := seen.Get(client.HeaderOpaqueID); v != "terraform/test/opensearch/configure" {
+			t.Errorf("unexpected %s header: %q", client.HeaderOpaqueID, v)
+		}
+	})
+
+	t.Run("older-than-minimum-throws-error", func(t *testing.T) {
+		_, _, _, diags := check(t, openSearchInfo, client.Version{Major: 2, Minor: 12})
+		if !diags.HasError() {
+			t.Fatal("expected error")
+		}
+		if d := diags.Errors()[0].Detail(); !strings.Contains(d, "2.11.0") || !strings.Contains(d, "2.12.0") {
+			t.Errorf("expected error to reference both versions, saw %q", d)
+		}
+	})
+
+	t.Run("elasticsearch-throws-error", func(t *testing.T) {
+		_, _, _, diags := check(t, elasticsearchInfo, client.Version{})
+		if !diags.HasError() {
+			t.Fatal("expected error")
+		}
+		if d := diags.Errors()[0].Detail(); !strings.Contains(d, client.DistributionElasticsearch) {
+			t.Errorf("expected error to reference %s, saw %q", client.DistributionElasticsearch, d)
+		}
+	})
+}
+
+func TestUnit_CheckMinimumVersion(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cluster client.Version
+		err     bool
+	}{
+		"unknown": {cluster: client.Version{}},
+		"same":    {cluster: client.Version{Major: 2, Minor: 4}},
+		"newer":   {cluster: client.Version{Major: 3}},
+		"older":   {cluster: client.Version{Major: 2, Minor: 3, Patch: 9}, err: true},
+	} {
+		var diags diag.Diagnostics
+		checkMinimumVersion("opensearch_example", client.Version{Major: 2, Minor: 4}, tc.cluster, &diags)
+		if diags.HasError() != tc.err {
+			t.Errorf("%s: expected error %t, saw %v", name, tc.err, diags)
+		}
+	}
+}
+
+func TestUnit_ModifyPlanMinimumVersion(t *testing.T) {
+	ctx := context.Background()
+
+	r := NewPluginSecurityAllowlistResource().(*PluginSecurityAllowlistResource)
+	schemaResp := new(resource.SchemaResponse)
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+
+	for name, tc := range map[string]struct {
+		cluster client.Version
+		destroy bool
+		err     bool
+	}{
+		"unknown": {cluster: client.Version{}},
+		"newer":   {cluster: client.Version{Major: 2, Minor: 11}},
+		"older":   {cluster: client.Version{Major: 1, Minor: 3, Patch: 14}, err: true},
+		"destroy": {cluster: client.Version{Major: 1, Minor: 3, Patch: 14}, destroy: true},
+	} {
+		configureResp := new(resource.ConfigureResponse)
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: &Shared{ClusterVersion: tc.cluster}}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected errors: %v", name, configureResp.Diagnostics)
+		}
+
+		raw := tftypes.NewValue(typ, tftypes.UnknownValue)
+		if tc.destroy {
+			raw = tftypes.NewValue(typ, nil)
+		}
+		req := resource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() != tc.err {
+			t.Errorf("%s: expected error %t, saw %v", name, tc.err, resp.Diagnostics)
+		} else if tc.err && !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "requires OpenSearch 2.0.0") {
+			t.Errorf("%s: expected error to reference the minimum version, saw %q", name, resp.Diagnostics.Errors()[0].Detail())
+		}
+	}
+}
+
+func TestUnit_MinimumVersionDeclared(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")
+	older := &Shared{ClusterVersion: client.Version{Minor: 9}}
+
+	// every type declares a minimum, so a cluster older than any supported release fails each check
+	for _, fn := range p.Resources(ctx) {
+		r := fn()
+		metaResp := new(resource.MetadataResponse)
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: fields.ProviderName}, metaResp)
+
+		r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: older}, new(resource.ConfigureResponse))
+		schemaResp := new(resource.SchemaResponse)
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		typ := schemaResp.Schema.Type().TerraformType(ctx)
+
+		req := resource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, tftypes.UnknownValue)}}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected a minimum version to be declared", metaResp.TypeName)
+		}
+	}
+
+	for _, fn := range p.DataSources(ctx) {
+		d := fn()
+		metaResp := new(datasource.MetadataResponse)
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: fields.ProviderName}, metaResp)
+
+		d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: older}, new(datasource.ConfigureResponse))
+		var diags diag.Diagnostics
+		d.(interface{ checkMinimumVersion(*diag.Diagnostics) }).checkMinimumVersion(&diags)
+		if !diags.HasError() {
+			t.Errorf("%s: expected a minimum version to be declared", metaResp.TypeName)
+		}
+	}
+}
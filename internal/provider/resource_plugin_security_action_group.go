@@ -0,0 +1,610 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	pluginSecurityActionGroupTypes = []string{"cluster", "index", "kibana"}
+)
+
+func NewPluginSecurityActionGroupResource() resource.Resource {
+	r := new(PluginSecurityActionGroupResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginActionGroup)
+	r.minimumVersion = minimumPluginSecurityVersion
+	return r
+}
+
+type PluginSecurityActionGroupResource struct {
+	ResourceShared
+}
+
+type PluginSecurityActionGroupResourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	ActionGroupName types.String `tfsdk:"action_group_name"`
+	Description     types.String `tfsdk:"description"`
+	Type            types.String `tfsdk:"type"`
+	AllowedActions  types.List   `tfsdk:"allowed_actions"`
+
+	Reserved types.Bool `tfsdk:"reserved"`
+	Hidden   types.Bool `tfsdk:"hidden"`
+	Static   types.Bool `tfsdk:"static"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (d *PluginSecurityActionGroupResourceData) UpdateFromActionGroup(actionGroupName string, g client.PluginSecurityActionGroup) diag.Diagnostics {
+	d.ActionGroupName = types.StringValue(actionGroupName)
+
+	// set id to action group name so framework is happy
+	d.ID = d.ActionGroupName
+
+	d.Description = types.StringValue(g.Description)
+	d.Type = types.StringValue(g.Type)
+	d.AllowedActions = conv.StringsToStringList(g.AllowedActions, false)
+
+	// set "computed" values
+	d.Hidden = conv.BoolPtrToBoolValue(g.Hidden)
+	d.Static = conv.BoolPtrToBoolValue(g.Static)
+	d.Reserved = conv.BoolPtrToBoolValue(g.Reserved)
+
+	return diag.Diagnostics{}
+}
+
+func (r *PluginSecurityActionGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.ResourceTypeSecurityPluginActionGroup)
+}
+
+func (r *PluginSecurityActionGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin Action Group",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrActionGroupName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validation.Required(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			fields.ResourceAttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					defaultValuedStringPlanModifier(""),
+				},
+			},
+			fields.ResourceAttrType: schema.StringAttribute{
+				Description: "Type of action group.  One of: cluster, index, kibana",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validation.Compare(validation.OneOf, pluginSecurityActionGroupTypes),
+				},
+				PlanModifiers: []planmodifier.String{
+					defaultValuedStringPlanModifier(""),
+				},
+			},
+			fields.ResourceAttrAllowedActions: schema.ListAttribute{
+				Description: "Actions and / or other action groups allowed by this action group",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrStatic: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrHidden: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrReserved: schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *PluginSecurityActionGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		actionGroupName string
+		osGroup         client.PluginSecurityActionGroup
+		ok              bool
+
+		planData = new(PluginSecurityActionGroupResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+
+	// extract action group name
+	actionGroupName = planData.ActionGroupName.ValueString()
+
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchActionGroups(ctx, r.client, actionGroupName)
+		if err == nil {
+			resp.Diagnostics.AddError(
+				"Action group already exists",
+				fmt.Sprintf("Action group %q already exists in cluster", actionGroupName),
+			)
+			return
+		}
+		// anything other than not found means existence could not be determined, so the create is not attempted
+		if !client.IsNotFound(err) {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for action group",
+					fmt.Sprintf("Error occurred looking for existing action group %q: %v", actionGroupName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute create request
+	{
+		// init request type
+		osReq := &client.PluginSecurityActionGroupUpsertRequest{
+			Name: actionGroupName,
+		}
+
+		// convert plan data to opensearch model
+		osGroup = terraformSecurityActionGroupToSecurityActionGroup(planData)
+
+		jsonB, err := json.Marshal(osGroup)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		// execute create call
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating action group",
+				fmt.Sprintf("Error executing create action group request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		createResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &createResp, http.StatusCreated); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing create action group response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if createResp.HasErrors() {
+			createResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+
+		// check for warnings
+		if len(createResp.WarningsHeader) > 0 {
+			for _, w := range createResp.WarningsHeader {
+				resp.Diagnostics.AddWarning(
+					w,
+					fmt.Sprintf("Warning received after creating action group %q: %v", actionGroupName, w),
+				)
+			}
+		}
+	}
+
+	// attempt to fetch newly created action group
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osGroups, err := tryFetchActionGroups(ctx, r.client, actionGroupName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching newly created action group",
+				fmt.Sprintf("Error fetching newly created action group %q: %v", actionGroupName, err.Error()),
+			)
+			return
+		}
+		if osGroup, ok = osGroups[actionGroupName]; !ok {
+			resp.Diagnostics.AddError(
+				"Action group not found",
+				fmt.Sprintf("Unable to locate newly created action group %q", actionGroupName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromActionGroup(actionGroupName, osGroup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityActionGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		actionGroupName string
+		osGroup         client.PluginSecurityActionGroup
+		updateDiags     diag.Diagnostics
+		ok              bool
+
+		stateData = new(PluginSecurityActionGroupResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+
+	// extract action group name
+	actionGroupName = stateData.ActionGroupName.ValueString()
+
+	// query for action group from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osGroups, err := tryFetchActionGroups(ctx, r.client, actionGroupName)
+		if err != nil {
+			// action group was deleted outside of terraform, remove from state so the next plan recreates it
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for action group",
+					fmt.Sprintf("Error occurred querying for action group %q: %v", actionGroupName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract action group from response, treating absence as drift
+		if osGroup, ok = osGroups[actionGroupName]; !ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	// update data object from source action group
+	updateDiags = stateData.UpdateFromActionGroup(actionGroupName, osGroup)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
+
+func (r *PluginSecurityActionGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		actionGroupName string
+		osGroup         client.PluginSecurityActionGroup
+		ok              bool
+
+		planData = new(PluginSecurityActionGroupResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+
+	// extract action group name
+	actionGroupName = planData.ActionGroupName.ValueString()
+
+	// attempt to locate action group in cluster
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchActionGroups(ctx, r.client, actionGroupName)
+		if err != nil {
+			if client.IsNotFound(err) {
+				// if the action group was not found, prevent the update call from creating a new one.
+				resp.Diagnostics.AddError(
+					"Action group not found",
+					fmt.Sprintf("Action group %q was not found in cluster", actionGroupName),
+				)
+			} else if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for action group",
+					fmt.Sprintf("Error occurred querying for action group %q: %v", actionGroupName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute update call
+	{
+		// init request type
+		osReq := &client.PluginSecurityActionGroupUpsertRequest{
+			Name: actionGroupName,
+		}
+
+		// convert plan data to opensearch model
+		osGroup = terraformSecurityActionGroupToSecurityActionGroup(planData)
+
+		jsonB, err := json.Marshal(osGroup)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating action group",
+				fmt.Sprintf("Error executing update action group request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		updateResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &updateResp, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing update action group response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if updateResp.HasErrors() {
+			updateResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+
+	// attempt to fetch updated action group
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osGroups, err := tryFetchActionGroups(ctx, r.client, actionGroupName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching updated action group",
+				fmt.Sprintf("Error fetching updated action group %q: %v", actionGroupName, err.Error()),
+			)
+			return
+		}
+		if osGroup, ok = osGroups[actionGroupName]; !ok {
+			resp.Diagnostics.AddError(
+				"Action group not found",
+				fmt.Sprintf("Unable to locate updated action group %q", actionGroupName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromActionGroup(actionGroupName, osGroup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityActionGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		actionGroupName string
+
+		stateData = new(PluginSecurityActionGroupResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+
+	// extract action group name
+	actionGroupName = stateData.ActionGroupName.ValueString()
+
+	// execute delete call
+	{
+		osReq := &client.PluginSecurityActionGroupDeleteRequest{
+			Name: actionGroupName,
+		}
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error deleting action group",
+					fmt.Sprintf("Error occurred deleting action group %q: %v", actionGroupName, err),
+				)
+			}
+			return
+		}
+
+		// attempt to parse response
+		sink := client.APIStatusResponse{}
+		if err = client.ParseResponse(osResp, &sink, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing delete action group response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		if sink.HasErrors() {
+			sink.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+}
+
+func (r *PluginSecurityActionGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		actionGroupName string
+		osGroup         client.PluginSecurityActionGroup
+		updateDiags     diag.Diagnostics
+		ok              bool
+
+		stateData = new(PluginSecurityActionGroupResourceData)
+	)
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	ctx = r.operationContext(ctx, operationImport)
+
+	// extract action group name
+	actionGroupName = req.ID
+
+	// query for action group from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osGroups, err := tryFetchActionGroups(ctx, r.client, actionGroupName)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for action group",
+					fmt.Sprintf("Error occurred querying for action group %q: %v", actionGroupName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract action group from response
+		if osGroup, ok = osGroups[actionGroupName]; !ok {
+			resp.Diagnostics.AddError(
+				"Action group not found",
+				fmt.Sprintf("Action group %q not found", actionGroupName),
+			)
+			return
+		}
+	}
+
+	// update data object from source action group
+	updateDiags = stateData.UpdateFromActionGroup(actionGroupName, osGroup)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityActionGroup(t *testing.T) {
+	const (
+		resourceName = "test_action_group"
+		renamed      = resourceName + "_renamed"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginActionGroup, resourceName)
+	)
+
+	t.Run("empty-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityActionGroupConfigWith(resourceName, nil),
+					),
+					ExpectError: regexp.MustCompile("required"),
+				},
+			},
+		})
+	})
+
+	t.Run("invalid-type-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityActionGroupValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrType: "not-a-type",
+						}),
+					),
+					ExpectError: regexp.MustCompile("must be one of"),
+				},
+			},
+		})
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		const (
+			allowedAction1 = "indices:data/read/search*"
+			allowedAction2 = "indices:data/read/get*"
+		)
+
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityActionGroupValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrType: "index",
+							fields.ResourceAttrAllowedActions: []string{
+								allowedAction1,
+								allowedAction2,
+							},
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrActionGroupName, resourceName),
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrType, "index"),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0", fields.ResourceAttrAllowedActions),
+							allowedAction1,
+						),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.1", fields.ResourceAttrAllowedActions),
+							allowedAction2,
+						),
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrReserved, "false"),
+					),
+				},
+				{
+					ResourceName:      resourceFQN,
+					ImportState:       true,
+					ImportStateId:     resourceName,
+					ImportStateVerify: true,
+				},
+				// renaming replaces the action group, as the old name cannot be updated in place
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityActionGroupValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrActionGroupName: renamed,
+							fields.ResourceAttrType:            "index",
+							fields.ResourceAttrAllowedActions:  []string{allowedAction1},
+						}),
+					),
+					Check: resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrActionGroupName, renamed),
+				},
+			},
+		})
+	})
+}
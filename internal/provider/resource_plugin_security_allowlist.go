@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// pluginSecurityAllowlistID is the static id of the singleton allowlist resource
+	pluginSecurityAllowlistID = "config"
+)
+
+func NewPluginSecurityAllowlistResource() resource.Resource {
+	r := new(PluginSecurityAllowlistResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginAllowlist)
+	r.minimumVersion = minimumPluginSecurityAllowlistVersion
+	return r
+}
+
+type PluginSecurityAllowlistResource struct {
+	ResourceShared
+}
+
+type PluginSecurityAllowlistResourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	Enabled  types.Bool `tfsdk:"enabled"`
+	Requests types.Map  `tfsdk:"requests"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (d *PluginSecurityAllowlistResourceData) UpdateFromAllowlist(c client.PluginSecurityAllowlistConfig) diag.Diagnostics {
+	// there is only ever one allowlist
+	d.ID = types.StringValue(pluginSecurityAllowlistID)
+
+	d.Enabled = types.BoolValue(c.Enabled)
+
+	requests := make(map[string]attr.Value, len(c.Requests))
+	for k, v := range c.Requests {
+		requests[k] = conv.StringsToStringList(v, false)
+	}
+
+	var diags diag.Diagnostics
+	d.Requests, diags = types.MapValue(types.ListType{ElemType: types.StringType}, requests)
+
+	return diags
+}
+
+func (r *PluginSecurityAllowlistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.ResourceTypeSecurityPluginAllowlist)
+}
+
+func (r *PluginSecurityAllowlistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin REST API allowlist.  This is a singleton: only one instance should exist" +
+			" per cluster.  When enabled, only the listed endpoints and methods are reachable by non-admin users.  Any" +
+			" value not set is left as-is, and destroying this resource restores the security plugin defaults.  Requires" +
+			" OpenSearch 2.0 or later.",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrEnabled: schema.BoolAttribute{
+				Description: "Enable allowlisting",
+				Optional:    true,
+				Computed:    true,
+			},
+			fields.ResourceAttrRequests: schema.MapAttribute{
+				Description: "Map of endpoint path to the HTTP methods allowed on it",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
+	}
+}
+
+// fetchAllowlist queries for the current allowlist, appending any errors seen to the provided diagnostics
+func (r *PluginSecurityAllowlistResource) fetchAllowlist(ctx context.Context, diags *diag.Diagnostics) (client.PluginSecurityAllowlistConfig, bool) {
+	_, allowlistResp, err := tryFetchAllowlist(ctx, r.client)
+	if err != nil {
+		if m, ok := err.(*client.APIStatusResponse); ok {
+			m.AppendDiagnostics(diags)
+		} else {
+			diags.AddError(
+				"Error querying for allowlist",
+				fmt.Sprintf("Error occurred querying for allowlist: %v", err.Error()),
+			)
+		}
+		return client.PluginSecurityAllowlistConfig{}, false
+	}
+
+	return allowlistResp.Config, true
+}
+
+// putAllowlist replaces the entire allowlist, appending any errors seen to the provided diagnostics
+func (r *PluginSecurityAllowlistResource) putAllowlist(ctx context.Context, conf client.PluginSecurityAllowlistConfig, diags *diag.Diagnostics) bool {
+	// init request type
+	osReq := &client.PluginSecurityAllowlistUpdateRequest{}
+
+	jsonB, err := json.Marshal(conf)
+	if err != nil {
+		diags.AddError(
+			"Error marshalling plan into OpenSearch request",
+			fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+		)
+		return false
+	}
+
+	// set request body
+	osReq.Body = bytes.NewReader(jsonB)
+
+	osResp, err := osReq.Do(ctx, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error updating allowlist",
+			fmt.Sprintf("Error executing update allowlist request: %v", err),
+		)
+		return false
+	}
+
+	// create response container
+	updateResp := client.APIStatusResponse{}
+
+	// attempt to parse response
+	if err = client.ParseResponse(osResp, &updateResp, http.StatusOK); err != nil {
+		if m, ok := err.(*client.APIStatusResponse); ok {
+			m.AppendDiagnostics(diags)
+		} else {
+			diags.AddError(
+				"Error parsing update allowlist response",
+				err.Error(),
+			)
+		}
+		return false
+	}
+
+	// check for errors
+	if updateResp.HasErrors() {
+		updateResp.AppendDiagnostics(diags)
+		return false
+	}
+
+	return true
+}
+
+// applyPlan merges the known plan values on top of the current cluster config, persists the result, and updates the
+// provided model with what the cluster reports afterwards.
+func (r *PluginSecurityAllowlistResource) applyPlan(ctx context.Context, planData *PluginSecurityAllowlistResourceData, diags *diag.Diagnostics) {
+	// fetch current config so unset values are left as-is
+	conf, ok := r.fetchAllowlist(ctx, diags)
+	if !ok {
+		return
+	}
+
+	// apply plan on top of current config
+	overlayTerraformAllowlist(planData, &conf)
+
+	// persist
+	if !r.putAllowlist(ctx, conf, diags) {
+		return
+	}
+
+	// refresh from cluster
+	if conf, ok = r.fetchAllowlist(ctx, diags); !ok {
+		return
+	}
+
+	diags.Append(planData.UpdateFromAllowlist(conf)...)
+}
+
+func (r *PluginSecurityAllowlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		planData = new(PluginSecurityAllowlistResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	r.applyPlan(ctx, planData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		stateData = new(PluginSecurityAllowlistResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	conf, ok := r.fetchAllowlist(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// update data object from cluster config
+	resp.Diagnostics.Append(stateData.UpdateFromAllowlist(conf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
+
+func (r *PluginSecurityAllowlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		planData = new(PluginSecurityAllowlistResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	r.applyPlan(ctx, planData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityAllowlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		stateData = new(PluginSecurityAllowlistResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	// the allowlist cannot be removed, only restored to defaults
+	r.putAllowlist(ctx, client.DefaultPluginSecurityAllowlistConfig(), &resp.Diagnostics)
+}
+
+func (r *PluginSecurityAllowlistResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		stateData = new(PluginSecurityAllowlistResourceData)
+	)
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	ctx, cancel := context.WithDeadline(r.operationContext(ctx, operationImport), r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics))
+	defer cancel()
+
+	conf, ok := r.fetchAllowlist(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// update data object from cluster config
+	resp.Diagnostics.Append(stateData.UpdateFromAllowlist(conf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityAllowlist(t *testing.T) {
+	const (
+		resourceName = "test_allowlist"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginAllowlist, resourceName)
+	)
+
+	t.Run("basic", func(t *testing.T) {
+		const (
+			endpoint = "/_cat/indices"
+		)
+
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityAllowlistConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrEnabled: false,
+							fields.ResourceAttrRequests: map[string]interface{}{
+								// keys are rendered verbatim, so the path must be quoted
+								fmt.Sprintf("%q", endpoint): []string{"GET"},
+							},
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrEnabled, "false"),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.%s.0", fields.ResourceAttrRequests, endpoint),
+							"GET",
+						),
+					),
+				},
+				{
+					ResourceName:      resourceFQN,
+					ImportState:       true,
+					ImportStateId:     "config",
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,529 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// pluginSecurityAuditConfigID is the static id of the singleton audit configuration resource
+	pluginSecurityAuditConfigID = "config"
+)
+
+// jsonPointerUnescaper decodes a single JSON pointer (RFC 6901) reference token
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+func NewPluginSecurityAuditConfigResource() resource.Resource {
+	r := new(PluginSecurityAuditConfigResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginAuditConfig)
+	r.minimumVersion = minimumPluginSecurityVersion
+	return r
+}
+
+type PluginSecurityAuditConfigResource struct {
+	ResourceShared
+}
+
+type PluginSecurityAuditConfigResourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Audit      types.Object `tfsdk:"audit"`
+	Compliance types.Object `tfsdk:"compliance"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (d *PluginSecurityAuditConfigResourceData) UpdateFromAuditConfig(c client.PluginSecurityAuditConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// there is only ever one audit config
+	d.ID = types.StringValue(pluginSecurityAuditConfigID)
+
+	d.Enabled = types.BoolValue(c.Enabled)
+
+	if d.Audit, diags = auditSettingsToTerraformObject(c.Audit); diags.HasError() {
+		return diags
+	}
+	if d.Compliance, diags = complianceSettingsToTerraformObject(c.Compliance); diags.HasError() {
+		return diags
+	}
+
+	return diag.Diagnostics{}
+}
+
+func (r *PluginSecurityAuditConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.ResourceTypeSecurityPluginAuditConfig)
+}
+
+func (r *PluginSecurityAuditConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin audit logging configuration.  This is a singleton: only one instance" +
+			" should exist per cluster.  Any value not set is left as-is, and destroying this resource restores the" +
+			" security plugin defaults, except for the keys the cluster reports as read-only.",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrEnabled: schema.BoolAttribute{
+				Description: "Enable audit logging",
+				Optional:    true,
+				Computed:    true,
+			},
+			fields.ResourceAttrAudit: schema.SingleNestedAttribute{
+				Description: "General audit logging settings",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					fields.ResourceAttrEnableRest: schema.BoolAttribute{
+						Description: "Log REST layer events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrDisabledRestCategories: schema.ListAttribute{
+						Description: "REST layer event categories that are not logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					fields.ResourceAttrEnableTransport: schema.BoolAttribute{
+						Description: "Log transport layer events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrDisabledTransportCategories: schema.ListAttribute{
+						Description: "Transport layer event categories that are not logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					fields.ResourceAttrResolveBulkRequests: schema.BoolAttribute{
+						Description: "Log individual operations of bulk requests",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrLogRequestBody: schema.BoolAttribute{
+						Description: "Include the request body in audit events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrResolveIndices: schema.BoolAttribute{
+						Description: "Resolve index aliases and wildcards in audit events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrExcludeSensitiveHeaders: schema.BoolAttribute{
+						Description: "Exclude sensitive headers, such as Authorization, from audit events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrIgnoreUsers: schema.ListAttribute{
+						Description: "Users whose requests are not logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					fields.ResourceAttrIgnoreRequests: schema.ListAttribute{
+						Description: "Request patterns that are not logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			fields.ResourceAttrCompliance: schema.SingleNestedAttribute{
+				Description: "Compliance audit logging settings",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					fields.ResourceAttrEnabled: schema.BoolAttribute{
+						Description: "Enable compliance logging",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrInternalConfig: schema.BoolAttribute{
+						Description: "Log changes to the security plugin configuration index",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrExternalConfig: schema.BoolAttribute{
+						Description: "Log external configuration, such as opensearch.yml, on node startup",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrReadMetadataOnly: schema.BoolAttribute{
+						Description: "Only log metadata of read events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrReadWatchedFields: schema.MapAttribute{
+						Description: "Map of index pattern to the fields whose reads are logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.ListType{ElemType: types.StringType},
+					},
+					fields.ResourceAttrReadIgnoreUsers: schema.ListAttribute{
+						Description: "Users whose read events are not logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					fields.ResourceAttrWriteMetadataOnly: schema.BoolAttribute{
+						Description: "Only log metadata of write events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrWriteLogDiffs: schema.BoolAttribute{
+						Description: "Log a diff of document changes for write events",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrWriteWatchedIndices: schema.ListAttribute{
+						Description: "Index patterns whose write events are logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					fields.ResourceAttrWriteIgnoreUsers: schema.ListAttribute{
+						Description: "Users whose write events are not logged",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
+	}
+}
+
+// fetchAuditConfig queries for the current audit config and the JSON pointers of its read-only keys, appending any
+// errors seen to the provided diagnostics
+func (r *PluginSecurityAuditConfigResource) fetchAuditConfig(ctx context.Context, diags *diag.Diagnostics) (client.PluginSecurityAuditConfig, []string, bool) {
+	_, auditResp, err := tryFetchAuditConfig(ctx, r.client)
+	if err != nil {
+		if m, ok := err.(*client.APIStatusResponse); ok {
+			m.AppendDiagnostics(diags)
+		} else {
+			diags.AddError(
+				"Error querying for audit config",
+				fmt.Sprintf("Error occurred querying for audit config: %v", err.Error()),
+			)
+		}
+		return client.PluginSecurityAuditConfig{}, nil, false
+	}
+
+	return auditResp.Config, auditResp.ReadOnly, true
+}
+
+// resetAuditConfig returns the provided config with every key the cluster allows to be changed restored to its
+// default.  The read-only keys, given as JSON pointers, are removed from the defaults before they are overlaid on the
+// current config, as the cluster rejects any change to them.
+func resetAuditConfig(current client.PluginSecurityAuditConfig, readOnly []string) (client.PluginSecurityAuditConfig, error) {
+	var (
+		defaults map[string]interface{}
+		conf     map[string]interface{}
+		out      client.PluginSecurityAuditConfig
+	)
+
+	if err := remarshalJSON(client.DefaultPluginSecurityAuditConfig(), &defaults); err != nil {
+		return out, err
+	}
+	if err := remarshalJSON(current, &conf); err != nil {
+		return out, err
+	}
+
+	for _, ptr := range readOnly {
+		removeJSONPointer(defaults, ptr)
+	}
+	overlayJSONObject(conf, defaults)
+
+	err := remarshalJSON(conf, &out)
+	return out, err
+}
+
+// remarshalJSON converts in to out by way of its json encoding
+func remarshalJSON(in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// removeJSONPointer removes the value the provided JSON pointer refers to from obj, if it exists
+func removeJSONPointer(obj map[string]interface{}, ptr string) {
+	segs := strings.Split(strings.TrimPrefix(ptr, "/"), "/")
+	for i, seg := range segs {
+		seg = jsonPointerUnescaper.Replace(seg)
+		if i == len(segs)-1 {
+			delete(obj, seg)
+			return
+		}
+		next, ok := obj[seg].(map[string]interface{})
+		if !ok {
+			return
+		}
+		obj = next
+	}
+}
+
+// overlayJSONObject copies each value in src into dst, merging nested objects
+func overlayJSONObject(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if sv, ok := v.(map[string]interface{}); ok {
+			if dv, ok := dst[k].(map[string]interface{}); ok {
+				overlayJSONObject(dv, sv)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+// putAuditConfig replaces the entire audit config, appending any errors seen to the provided diagnostics
+func (r *PluginSecurityAuditConfigResource) putAuditConfig(ctx context.Context, conf client.PluginSecurityAuditConfig, diags *diag.Diagnostics) bool {
+	// init request type
+	osReq := &client.PluginSecurityAuditUpdateRequest{}
+
+	jsonB, err := json.Marshal(conf)
+	if err != nil {
+		diags.AddError(
+			"Error marshalling plan into OpenSearch request",
+			fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+		)
+		return false
+	}
+
+	// set request body
+	osReq.Body = bytes.NewReader(jsonB)
+
+	osResp, err := osReq.Do(ctx, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error updating audit config",
+			fmt.Sprintf("Error executing update audit config request: %v", err),
+		)
+		return false
+	}
+
+	// create response container
+	updateResp := client.APIStatusResponse{}
+
+	// attempt to parse response
+	if err = client.ParseResponse(osResp, &updateResp, http.StatusOK); err != nil {
+		if m, ok := err.(*client.APIStatusResponse); ok {
+			m.AppendDiagnostics(diags)
+		} else {
+			diags.AddError(
+				"Error parsing update audit config response",
+				err.Error(),
+			)
+		}
+		return false
+	}
+
+	// check for errors
+	if updateResp.HasErrors() {
+		updateResp.AppendDiagnostics(diags)
+		return false
+	}
+
+	return true
+}
+
+// applyPlan merges the known plan values on top of the current cluster config, persists the result, and updates the
+// provided model with what the cluster reports afterwards.
+func (r *PluginSecurityAuditConfigResource) applyPlan(ctx context.Context, planData *PluginSecurityAuditConfigResourceData, diags *diag.Diagnostics) {
+	// fetch current config so unset values are left as-is
+	conf, _, ok := r.fetchAuditConfig(ctx, diags)
+	if !ok {
+		return
+	}
+
+	// apply plan on top of current config
+	overlayTerraformAuditConfig(planData, &conf)
+
+	// persist
+	if !r.putAuditConfig(ctx, conf, diags) {
+		return
+	}
+
+	// refresh from cluster
+	if conf, _, ok = r.fetchAuditConfig(ctx, diags); !ok {
+		return
+	}
+
+	diags.Append(planData.UpdateFromAuditConfig(conf)...)
+}
+
+func (r *PluginSecurityAuditConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		planData = new(PluginSecurityAuditConfigResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	r.applyPlan(ctx, planData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityAuditConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		stateData = new(PluginSecurityAuditConfigResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	conf, _, ok := r.fetchAuditConfig(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// update data object from cluster config
+	resp.Diagnostics.Append(stateData.UpdateFromAuditConfig(conf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
+
+func (r *PluginSecurityAuditConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		planData = new(PluginSecurityAuditConfigResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	r.applyPlan(ctx, planData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityAuditConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		stateData = new(PluginSecurityAuditConfigResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	// fetch current config so read-only keys are left as-is
+	current, readOnly, ok := r.fetchAuditConfig(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// the audit config cannot be removed, only restored to defaults
+	conf, err := resetAuditConfig(current, readOnly)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring default audit config",
+			fmt.Sprintf("Error overlaying the default audit config on the current config: %v", err),
+		)
+		return
+	}
+
+	r.putAuditConfig(ctx, conf, &resp.Diagnostics)
+}
+
+func (r *PluginSecurityAuditConfigResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		stateData = new(PluginSecurityAuditConfigResourceData)
+	)
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	ctx, cancel := context.WithDeadline(r.operationContext(ctx, operationImport), r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics))
+	defer cancel()
+
+	conf, _, ok := r.fetchAuditConfig(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// update data object from cluster config
+	resp.Diagnostics.Append(stateData.UpdateFromAuditConfig(conf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opensearch-project/opensearch-go"
+)
+
+func TestAcc_PluginSecurityAuditConfig(t *testing.T) {
+	const (
+		resourceName = "test_audit_config"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginAuditConfig, resourceName)
+	)
+
+	t.Run("basic", func(t *testing.T) {
+		const (
+			ignoredUser = "test_ignored_user"
+		)
+
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityAuditConfigConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrEnabled: true,
+							fields.ResourceAttrAudit: map[string]interface{}{
+								fields.ResourceAttrEnableRest:  true,
+								fields.ResourceAttrIgnoreUsers: []string{ignoredUser},
+							},
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrEnabled, "true"),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.%s.0", fields.ResourceAttrAudit, fields.ResourceAttrIgnoreUsers),
+							ignoredUser,
+						),
+						resource.TestCheckResourceAttrSet(
+							resourceFQN,
+							fmt.Sprintf("%s.%s", fields.ResourceAttrCompliance, fields.ResourceAttrEnabled),
+						),
+					),
+				},
+				{
+					ResourceName:      resourceFQN,
+					ImportState:       true,
+					ImportStateId:     "config",
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
+
+func TestUnit_PluginSecurityAuditConfigDelete(t *testing.T) {
+	ctx := context.Background()
+
+	current := client.DefaultPluginSecurityAuditConfig()
+	current.Enabled = false
+	current.Audit.ExcludeSensitiveHeaders = false
+	current.Audit.IgnoreUsers = []string{"test_ignored_user"}
+	current.Compliance.Enabled = false
+	current.Compliance.InternalConfig = false
+	current.Compliance.ExternalConfig = true
+
+	readOnly := []string{"/compliance/internal_config", "/compliance/external_config", "/audit/exclude_sensitive_headers"}
+
+	// everything but the read-only keys is restored to its default
+	expected := client.DefaultPluginSecurityAuditConfig()
+	expected.Audit.ExcludeSensitiveHeaders = false
+	expected.Compliance.InternalConfig = false
+	expected.Compliance.ExternalConfig = true
+
+	var put *client.PluginSecurityAuditConfig
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(client.PluginSecurityAuditAPIResponse{ReadOnly: readOnly, Config: current})
+		case http.MethodPut:
+			put = new(client.PluginSecurityAuditConfig)
+			if err := json.NewDecoder(r.Body).Decode(put); err != nil {
+				t.Errorf("error decoding request body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"status":"OK"}`))
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	osClient, err := opensearch.NewClient(opensearch.Config{Addresses: []string{srv.URL}, UseResponseCheckOnly: true, DisableRetry: true})
+	if err != nil {
+		t.Fatalf("error constructing client: %v", err)
+	}
+	r := NewPluginSecurityAuditConfigResource().(*PluginSecurityAuditConfigResource)
+	r.Configure(ctx, tfresource.ConfigureRequest{ProviderData: &Shared{Client: osClient, DefaultTimeout: time.Second}}, new(tfresource.ConfigureResponse))
+
+	schemaResp := new(tfresource.SchemaResponse)
+	r.Schema(ctx, tfresource.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for k, at := range typ.AttributeTypes {
+		attrs[k] = tftypes.NewValue(at, nil)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, attrs)}
+
+	resp := &tfresource.DeleteResponse{State: state}
+	r.Delete(ctx, tfresource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if put == nil {
+		t.Fatal("expected the audit config to be replaced")
+	}
+	if !reflect.DeepEqual(*put, expected) {
+		t.Errorf("expected read-only keys to be kept\nexpected: %+v\nsaw:      %+v", expected, *put)
+	}
+}
@@ -0,0 +1,1256 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// pluginSecurityConfigID is the static id of the singleton security config resource
+	pluginSecurityConfigID = "config"
+
+	// pluginSecurityConfigCreatedDomainsKey is the private state key recording the domains created by the resource
+	pluginSecurityConfigCreatedDomainsKey = "created_domains"
+)
+
+var (
+	pluginSecurityConfigAuthenticationBackends = []string{
+		"intern",
+		"noop",
+		"ldap",
+	}
+
+	pluginSecurityConfigAuthenticators = []string{
+		fields.ResourceAttrBasic,
+		fields.ResourceAttrJWT,
+		fields.ResourceAttrOpenID,
+		fields.ResourceAttrSAML,
+		fields.ResourceAttrProxy,
+	}
+)
+
+func NewPluginSecurityConfigResource() resource.Resource {
+	r := new(PluginSecurityConfigResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginConfig)
+	r.minimumVersion = minimumPluginSecurityVersion
+	return r
+}
+
+type PluginSecurityConfigResource struct {
+	ResourceShared
+}
+
+type pluginSecurityConfigAuthcDomainData struct {
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	HTTPEnabled           types.Bool   `tfsdk:"http_enabled"`
+	TransportEnabled      types.Bool   `tfsdk:"transport_enabled"`
+	Order                 types.Int64  `tfsdk:"order"`
+	AuthenticationBackend types.String `tfsdk:"authentication_backend"`
+
+	Basic  types.Object `tfsdk:"basic"`
+	JWT    types.Object `tfsdk:"jwt"`
+	OpenID types.Object `tfsdk:"openid"`
+	SAML   types.Object `tfsdk:"saml"`
+	Proxy  types.Object `tfsdk:"proxy"`
+	LDAP   types.Object `tfsdk:"ldap"`
+}
+
+func (d pluginSecurityConfigAuthcDomainData) domainName() string {
+	return d.Name.ValueString()
+}
+
+type pluginSecurityConfigAuthzDomainData struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	HTTPEnabled      types.Bool   `tfsdk:"http_enabled"`
+	TransportEnabled types.Bool   `tfsdk:"transport_enabled"`
+
+	LDAP types.Object `tfsdk:"ldap"`
+}
+
+func (d pluginSecurityConfigAuthzDomainData) domainName() string {
+	return d.Name.ValueString()
+}
+
+// domainsByName returns the domain blocks in a terraform list value keyed by name, along with their names in the
+// order the blocks are defined.  Only the first block with a given name is returned.
+func domainsByName[T interface{ domainName() string }](ctx context.Context, l types.List) (map[string]T, []string, diag.Diagnostics) {
+	var (
+		domains []T
+		diags   diag.Diagnostics
+
+		out = make(map[string]T)
+	)
+
+	if !attributeValued(l) {
+		return out, nil, diags
+	}
+	if diags.Append(l.ElementsAs(ctx, &domains, false)...); diags.HasError() {
+		return out, nil, diags
+	}
+
+	names := make([]string, 0, len(domains))
+	for _, d := range domains {
+		name := d.domainName()
+		if _, ok := out[name]; ok {
+			continue
+		}
+		out[name] = d
+		names = append(names, name)
+	}
+	return out, names, diags
+}
+
+// pluginSecurityConfigDomainNames names a set of authc and authz domains
+type pluginSecurityConfigDomainNames struct {
+	Authc []string `json:"authc,omitempty"`
+	Authz []string `json:"authz,omitempty"`
+}
+
+// parsePluginSecurityConfigImportID parses an import id of "config" optionally followed by comma-separated
+// "authc:<name>" and "authz:<name>" entries naming the domains to import.  The id "config" imports no domains.
+func parsePluginSecurityConfigImportID(id string) (pluginSecurityConfigDomainNames, error) {
+	var names pluginSecurityConfigDomainNames
+	entries := strings.Split(id, ",")
+	if strings.TrimSpace(entries[0]) == "" || strings.TrimSpace(entries[0]) == pluginSecurityConfigID {
+		entries = entries[1:]
+	}
+	for _, entry := range entries {
+		kind, name, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || name == "" {
+			return names, fmt.Errorf("entry %q must be in the form \"authc:<name>\" or \"authz:<name>\"", entry)
+		}
+		switch kind {
+		case fields.ResourceAttrAuthc:
+			names.Authc = append(names.Authc, name)
+		case fields.ResourceAttrAuthz:
+			names.Authz = append(names.Authz, name)
+		default:
+			return names, fmt.Errorf("entry %q must begin with %q or %q", entry, fields.ResourceAttrAuthc, fields.ResourceAttrAuthz)
+		}
+	}
+	return names, nil
+}
+
+// getPluginSecurityConfigCreatedDomains reads the domains created by the resource from private state
+func getPluginSecurityConfigCreatedDomains(ctx context.Context, private interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}, diags *diag.Diagnostics) pluginSecurityConfigDomainNames {
+	var names pluginSecurityConfigDomainNames
+	b, d := private.GetKey(ctx, pluginSecurityConfigCreatedDomainsKey)
+	if diags.Append(d...); diags.HasError() || len(b) == 0 {
+		return names
+	}
+	if err := json.Unmarshal(b, &names); err != nil {
+		diags.AddError(
+			"Error reading private state",
+			fmt.Sprintf("Unable to decode the domains created by this resource: %v", err),
+		)
+	}
+	return names
+}
+
+// setPluginSecurityConfigCreatedDomains records the domains created by the resource in private state
+func setPluginSecurityConfigCreatedDomains(ctx context.Context, private interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}, names pluginSecurityConfigDomainNames, diags *diag.Diagnostics) {
+	b, err := json.Marshal(names)
+	if err != nil {
+		diags.AddError(
+			"Error writing private state",
+			fmt.Sprintf("Unable to encode the domains created by this resource: %v", err),
+		)
+		return
+	}
+	diags.Append(private.SetKey(ctx, pluginSecurityConfigCreatedDomainsKey, b)...)
+}
+
+// createdDomainNames returns the planned domains created by the resource: those created by a prior apply, and those
+// which do not yet exist in the cluster and so will be created by this one
+func createdDomainNames(planned map[string]struct{}, prior []string, remote map[string]struct{}) []string {
+	created := nameSet(prior)
+
+	var out []string
+	for name := range planned {
+		_, wasCreated := created[name]
+		_, exists := remote[name]
+		if wasCreated || !exists {
+			out = append(out, name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+type PluginSecurityConfigResourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	DoNotFailOnForbidden types.Bool   `tfsdk:"do_not_fail_on_forbidden"`
+	HTTP                 types.Object `tfsdk:"http"`
+	Kibana               types.Object `tfsdk:"kibana"`
+	Authc                types.List   `tfsdk:"authc"`
+	Authz                types.List   `tfsdk:"authz"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+// UpdateFromSecurityConfig updates the model from the provided cluster config.  Only the authc and authz domains
+// already present in the model or named by include are read back.
+func (d *PluginSecurityConfigResourceData) UpdateFromSecurityConfig(ctx context.Context, c client.PluginSecurityConfigDynamic, include pluginSecurityConfigDomainNames) diag.Diagnostics {
+	var (
+		diags diag.Diagnostics
+		d2    diag.Diagnostics
+	)
+
+	// there is only ever one security config
+	d.ID = types.StringValue(pluginSecurityConfigID)
+
+	d.DoNotFailOnForbidden = types.BoolValue(c.DoNotFailOnForbidden)
+
+	if d.HTTP, diags = pluginSecurityConfigHTTPToTerraformObject(c.HTTP); diags.HasError() {
+		return diags
+	}
+	if d.Kibana, diags = pluginSecurityConfigKibanaToTerraformObject(c.Kibana); diags.HasError() {
+		return diags
+	}
+
+	// authc.  domains keep the order of their blocks, and imported domains follow in the order they were named.
+	priorAuthc, authcNames, d2 := domainsByName[pluginSecurityConfigAuthcDomainData](ctx, d.Authc)
+	if diags.Append(d2...); diags.HasError() {
+		return diags
+	}
+	for _, name := range include.Authc {
+		if _, ok := priorAuthc[name]; !ok {
+			priorAuthc[name] = pluginSecurityConfigAuthcDomainData{}
+			authcNames = append(authcNames, name)
+		}
+	}
+	authc := make([]pluginSecurityConfigAuthcDomainData, 0, len(authcNames))
+	for _, name := range authcNames {
+		remote, ok := c.Authc[name]
+		if !ok {
+			continue
+		}
+		domain, d2 := authcDomainToTerraformAuthcDomain(name, remote, priorAuthc[name])
+		if diags.Append(d2...); diags.HasError() {
+			return diags
+		}
+		authc = append(authc, domain)
+	}
+	// absent blocks are an empty list rather than null
+	d.Authc, d2 = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pluginSecurityConfigAuthcDomainAttrTypeMap}, authc)
+	if diags.Append(d2...); diags.HasError() {
+		return diags
+	}
+
+	// authz
+	priorAuthz, authzNames, d2 := domainsByName[pluginSecurityConfigAuthzDomainData](ctx, d.Authz)
+	if diags.Append(d2...); diags.HasError() {
+		return diags
+	}
+	for _, name := range include.Authz {
+		if _, ok := priorAuthz[name]; !ok {
+			priorAuthz[name] = pluginSecurityConfigAuthzDomainData{}
+			authzNames = append(authzNames, name)
+		}
+	}
+	authz := make([]pluginSecurityConfigAuthzDomainData, 0, len(authzNames))
+	for _, name := range authzNames {
+		remote, ok := c.Authz[name]
+		if !ok {
+			continue
+		}
+		domain, d2 := authzDomainToTerraformAuthzDomain(name, remote, priorAuthz[name])
+		if diags.Append(d2...); diags.HasError() {
+			return diags
+		}
+		authz = append(authz, domain)
+	}
+	d.Authz, d2 = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pluginSecurityConfigAuthzDomainAttrTypeMap}, authz)
+	diags.Append(d2...)
+
+	return diags
+}
+
+func pluginSecurityConfigLDAPAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		fields.ResourceAttrHosts: schema.ListAttribute{
+			Description: "LDAP hosts, in host:port form",
+			Required:    true,
+			ElementType: types.StringType,
+		},
+		fields.ResourceAttrBindDN: schema.StringAttribute{
+			Description: "DN used to bind to the LDAP server",
+			Optional:    true,
+		},
+		fields.ResourceAttrPassword: schema.StringAttribute{
+			Description: "Password used to bind to the LDAP server",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		fields.ResourceAttrEnableSSL: schema.BoolAttribute{
+			Description: "Connect using LDAPS",
+			Optional:    true,
+		},
+		fields.ResourceAttrEnableStartTLS: schema.BoolAttribute{
+			Description: "Upgrade the connection using StartTLS",
+			Optional:    true,
+		},
+		fields.ResourceAttrVerifyHostnames: schema.BoolAttribute{
+			Description: "Verify the hostname of the LDAP server certificate",
+			Optional:    true,
+		},
+		fields.ResourceAttrPEMTrustedCAsContent: schema.StringAttribute{
+			Description: "PEM-encoded CA certificates used to verify the LDAP server",
+			Optional:    true,
+		},
+		fields.ResourceAttrUserBase: schema.StringAttribute{
+			Description: "Subtree searched for users",
+			Optional:    true,
+		},
+		fields.ResourceAttrUserSearch: schema.StringAttribute{
+			Description: "Filter used to search for users",
+			Optional:    true,
+		},
+		fields.ResourceAttrUsernameAttribute: schema.StringAttribute{
+			Description: "User entry attribute used as the username",
+			Optional:    true,
+		},
+		fields.ResourceAttrRoleBase: schema.StringAttribute{
+			Description: "Subtree searched for roles",
+			Optional:    true,
+		},
+		fields.ResourceAttrRoleSearch: schema.StringAttribute{
+			Description: "Filter used to search for roles",
+			Optional:    true,
+		},
+		fields.ResourceAttrUserRoleAttribute: schema.StringAttribute{
+			Description: "User entry attribute containing additional role DNs",
+			Optional:    true,
+		},
+		fields.ResourceAttrUserRoleName: schema.StringAttribute{
+			Description: "User entry attribute containing role names",
+			Optional:    true,
+		},
+		fields.ResourceAttrLDAPRoleName: schema.StringAttribute{
+			Description: "Role entry attribute used as the role name",
+			Optional:    true,
+		},
+		fields.ResourceAttrResolveNestedRoles: schema.BoolAttribute{
+			Description: "Resolve nested roles",
+			Optional:    true,
+		},
+	}
+}
+
+func (r *PluginSecurityConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.ResourceTypeSecurityPluginConfig)
+}
+
+func (r *PluginSecurityConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin dynamic configuration (config.dynamic).  This is a singleton: only one" +
+			" instance should exist per cluster.  Changes are applied with JSON Patch, so settings and authentication" +
+			" domains not defined here are left as-is.  Destroying this resource removes only the authc and authz" +
+			" domains it created, never imported domains nor the last authc domain, and leaves all other settings in" +
+			" place.  The cluster must allow modification of the security config via the REST API" +
+			" (plugins.security.unsupported.restapi.allow_securityconfig_modification).  The import id is \"config\"," +
+			" optionally followed by a comma-separated list of domains to adopt, e.g." +
+			" \"config,authc:basic_internal_auth_domain,authz:roles_from_myldap\".",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrDoNotFailOnForbidden: schema.BoolAttribute{
+				Description: "Filter out indices the user cannot access instead of failing the request",
+				Optional:    true,
+				Computed:    true,
+			},
+			fields.ResourceAttrHTTP: schema.SingleNestedAttribute{
+				Description: "HTTP settings",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					fields.ResourceAttrAnonymousAuthEnabled: schema.BoolAttribute{
+						Description: "Allow anonymous authentication",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrXFFEnabled: schema.BoolAttribute{
+						Description: "Resolve the client address from the X-Forwarded-For header",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrXFFInternalProxies: schema.StringAttribute{
+						Description: "Regular expression matching trusted proxies",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrXFFRemoteIPHeader: schema.StringAttribute{
+						Description: "Header containing the client address",
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+			fields.ResourceAttrKibana: schema.SingleNestedAttribute{
+				Description: "Dashboards and multitenancy settings",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					fields.ResourceAttrMultitenancyEnabled: schema.BoolAttribute{
+						Description: "Enable multitenancy",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrPrivateTenantEnabled: schema.BoolAttribute{
+						Description: "Enable the private tenant",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrDefaultTenant: schema.StringAttribute{
+						Description: "Tenant selected when a user first logs in",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrServerUsername: schema.StringAttribute{
+						Description: "Username of the Dashboards server user",
+						Optional:    true,
+						Computed:    true,
+					},
+					fields.ResourceAttrIndex: schema.StringAttribute{
+						Description: "Dashboards index name",
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrAuthc: schema.ListNestedBlock{
+				Description: "Authentication domains.  Exactly one of basic, jwt, openid, saml, or proxy must be set in" +
+					" each domain.  Domains are matched to the cluster by name, so the order of these blocks is not" +
+					" significant.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						fields.ResourceAttrName: schema.StringAttribute{
+							Description: "Domain name",
+							Required:    true,
+						},
+						fields.ResourceAttrDescription: schema.StringAttribute{
+							Description: "Domain description",
+							Optional:    true,
+						},
+						fields.ResourceAttrHTTPEnabled: schema.BoolAttribute{
+							Description: "Enable this domain on the REST layer.  Defaults to true",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Bool{
+								defaultValuedBoolPlanModifier(true),
+							},
+						},
+						fields.ResourceAttrTransportEnabled: schema.BoolAttribute{
+							Description: "Enable this domain on the transport layer.  Defaults to false",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Bool{
+								defaultValuedBoolPlanModifier(false),
+							},
+						},
+						fields.ResourceAttrOrder: schema.Int64Attribute{
+							Description: "Order in which this domain is evaluated",
+							Required:    true,
+						},
+						fields.ResourceAttrAuthenticationBackend: schema.StringAttribute{
+							Description: "Authentication backend type.  Defaults to \"ldap\" when ldap is set, \"intern\"" +
+								" for basic, and \"noop\" otherwise",
+							Optional: true,
+							Computed: true,
+							Validators: []validator.String{
+								validation.Compare(validation.OneOf, pluginSecurityConfigAuthenticationBackends),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						fields.ResourceAttrBasic: schema.SingleNestedBlock{
+							Description: "HTTP basic authentication",
+							Attributes: map[string]schema.Attribute{
+								fields.ResourceAttrChallenge: schema.BoolAttribute{
+									Description: "Send a WWW-Authenticate challenge to unauthenticated clients",
+									Optional:    true,
+									Computed:    true,
+									PlanModifiers: []planmodifier.Bool{
+										defaultValuedBoolPlanModifier(true),
+									},
+								},
+							},
+						},
+						fields.ResourceAttrJWT: schema.SingleNestedBlock{
+							Description: "JSON Web Token authentication",
+							Attributes: map[string]schema.Attribute{
+								fields.ResourceAttrSigningKey: schema.StringAttribute{
+									Description: "Key used to verify token signatures",
+									Required:    true,
+									Sensitive:   true,
+								},
+								fields.ResourceAttrJWTHeader: schema.StringAttribute{
+									Description: "Header containing the token",
+									Optional:    true,
+								},
+								fields.ResourceAttrJWTURLParameter: schema.StringAttribute{
+									Description: "URL parameter containing the token",
+									Optional:    true,
+								},
+								fields.ResourceAttrRolesKey: schema.StringAttribute{
+									Description: "Claim containing the user's backend roles",
+									Optional:    true,
+								},
+								fields.ResourceAttrSubjectKey: schema.StringAttribute{
+									Description: "Claim containing the username",
+									Optional:    true,
+								},
+								fields.ResourceAttrJWTClockSkewToleranceSeconds: schema.Int64Attribute{
+									Description: "Tolerated clock skew when validating token timestamps",
+									Optional:    true,
+								},
+							},
+						},
+						fields.ResourceAttrOpenID: schema.SingleNestedBlock{
+							Description: "OpenID Connect authentication",
+							Attributes: map[string]schema.Attribute{
+								fields.ResourceAttrOpenIDConnectURL: schema.StringAttribute{
+									Description: "URL of the identity provider's discovery document",
+									Required:    true,
+									Validators: []validator.String{
+										validation.IsURL(),
+									},
+								},
+								fields.ResourceAttrJWTHeader: schema.StringAttribute{
+									Description: "Header containing the token",
+									Optional:    true,
+								},
+								fields.ResourceAttrJWTURLParameter: schema.StringAttribute{
+									Description: "URL parameter containing the token",
+									Optional:    true,
+								},
+								fields.ResourceAttrRolesKey: schema.StringAttribute{
+									Description: "Claim containing the user's backend roles",
+									Optional:    true,
+								},
+								fields.ResourceAttrSubjectKey: schema.StringAttribute{
+									Description: "Claim containing the username",
+									Optional:    true,
+								},
+								fields.ResourceAttrJWTClockSkewToleranceSeconds: schema.Int64Attribute{
+									Description: "Tolerated clock skew when validating token timestamps",
+									Optional:    true,
+								},
+								fields.ResourceAttrEnableSSL: schema.BoolAttribute{
+									Description: "Use TLS when connecting to the identity provider",
+									Optional:    true,
+								},
+								fields.ResourceAttrVerifyHostnames: schema.BoolAttribute{
+									Description: "Verify the hostname of the identity provider certificate",
+									Optional:    true,
+								},
+								fields.ResourceAttrPEMTrustedCAsContent: schema.StringAttribute{
+									Description: "PEM-encoded CA certificates used to verify the identity provider",
+									Optional:    true,
+								},
+							},
+						},
+						fields.ResourceAttrSAML: schema.SingleNestedBlock{
+							Description: "SAML authentication",
+							Attributes: map[string]schema.Attribute{
+								fields.ResourceAttrIDPMetadataURL: schema.StringAttribute{
+									Description: "URL of the identity provider's metadata",
+									Required:    true,
+									Validators: []validator.String{
+										validation.IsURL(),
+									},
+								},
+								fields.ResourceAttrIDPEntityID: schema.StringAttribute{
+									Description: "Entity ID of the identity provider",
+									Required:    true,
+								},
+								fields.ResourceAttrSPEntityID: schema.StringAttribute{
+									Description: "Entity ID of the service provider",
+									Required:    true,
+								},
+								fields.ResourceAttrKibanaURL: schema.StringAttribute{
+									Description: "Base URL of OpenSearch Dashboards",
+									Required:    true,
+								},
+								fields.ResourceAttrRolesKey: schema.StringAttribute{
+									Description: "Assertion attribute containing the user's backend roles",
+									Optional:    true,
+								},
+								fields.ResourceAttrSubjectKey: schema.StringAttribute{
+									Description: "Assertion attribute containing the username",
+									Optional:    true,
+								},
+								fields.ResourceAttrExchangeKey: schema.StringAttribute{
+									Description: "Key used to sign the tokens issued after a successful login",
+									Required:    true,
+									Sensitive:   true,
+								},
+							},
+						},
+						fields.ResourceAttrProxy: schema.SingleNestedBlock{
+							Description: "Authentication via headers set by a trusted proxy.  Requires http.xff_enabled",
+							Attributes: map[string]schema.Attribute{
+								fields.ResourceAttrUserHeader: schema.StringAttribute{
+									Description: "Header containing the username",
+									Required:    true,
+								},
+								fields.ResourceAttrRolesHeader: schema.StringAttribute{
+									Description: "Header containing the user's backend roles",
+									Optional:    true,
+								},
+							},
+						},
+						fields.ResourceAttrLDAP: schema.SingleNestedBlock{
+							Description: "LDAP authentication backend",
+							Attributes:  pluginSecurityConfigLDAPAttributes(),
+						},
+					},
+				},
+			},
+			fields.ResourceAttrAuthz: schema.ListNestedBlock{
+				Description: "Authorization domains.  Domains are matched to the cluster by name, so the order of these" +
+					" blocks is not significant.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						fields.ResourceAttrName: schema.StringAttribute{
+							Description: "Domain name",
+							Required:    true,
+						},
+						fields.ResourceAttrDescription: schema.StringAttribute{
+							Description: "Domain description",
+							Optional:    true,
+						},
+						fields.ResourceAttrHTTPEnabled: schema.BoolAttribute{
+							Description: "Enable this domain on the REST layer.  Defaults to true",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Bool{
+								defaultValuedBoolPlanModifier(true),
+							},
+						},
+						fields.ResourceAttrTransportEnabled: schema.BoolAttribute{
+							Description: "Enable this domain on the transport layer.  Defaults to false",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Bool{
+								defaultValuedBoolPlanModifier(false),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						fields.ResourceAttrLDAP: schema.SingleNestedBlock{
+							Description: "LDAP authorization backend.  Must be set in every authorization domain",
+							Attributes:  pluginSecurityConfigLDAPAttributes(),
+						},
+					},
+				},
+			},
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *PluginSecurityConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		confData = new(PluginSecurityConfigResourceData)
+
+		authc []pluginSecurityConfigAuthcDomainData
+		authz []pluginSecurityConfigAuthzDomainData
+	)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, confData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attributeValued(confData.Authc) {
+		resp.Diagnostics.Append(confData.Authc.ElementsAs(ctx, &authc, false)...)
+	}
+	if attributeValued(confData.Authz) {
+		resp.Diagnostics.Append(confData.Authz.ElementsAs(ctx, &authz, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]struct{}, len(authc))
+	for i, d := range authc {
+		p := path.Root(fields.ResourceAttrAuthc).AtListIndex(i)
+
+		validateDomainName(d.Name, p, seen, &resp.Diagnostics)
+
+		// unknown values count as set, they will be validated again once known
+		set := 0
+		for _, o := range []types.Object{d.Basic, d.JWT, d.OpenID, d.SAML, d.Proxy} {
+			if !o.IsNull() {
+				set++
+			}
+		}
+		if set != 1 {
+			resp.Diagnostics.AddAttributeError(
+				p,
+				"Invalid authentication domain",
+				fmt.Sprintf("Exactly one of %s must be set", strings.Join(pluginSecurityConfigAuthenticators, ", ")),
+			)
+		}
+
+		if attributeValued(d.AuthenticationBackend) {
+			backend := d.AuthenticationBackend.ValueString()
+			if backend == "ldap" && d.LDAP.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					p.AtName(fields.ResourceAttrLDAP),
+					"Missing LDAP settings",
+					"ldap must be set when authentication_backend is \"ldap\"",
+				)
+			} else if backend != "ldap" && !d.LDAP.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					p.AtName(fields.ResourceAttrAuthenticationBackend),
+					"Conflicting authentication backend",
+					fmt.Sprintf("authentication_backend must be \"ldap\" or unset when ldap is set, saw %q", backend),
+				)
+			}
+		}
+	}
+
+	seen = make(map[string]struct{}, len(authz))
+	for i, d := range authz {
+		p := path.Root(fields.ResourceAttrAuthz).AtListIndex(i)
+
+		validateDomainName(d.Name, p, seen, &resp.Diagnostics)
+
+		// blocks cannot be required by the schema
+		if d.LDAP.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				p.AtName(fields.ResourceAttrLDAP),
+				"Missing LDAP settings",
+				"ldap must be set in every authorization domain",
+			)
+		}
+	}
+}
+
+// validateDomainName ensures each known domain name is used by only one block of its kind, recording it in seen
+func validateDomainName(name types.String, p path.Path, seen map[string]struct{}, diags *diag.Diagnostics) {
+	if !attributeValued(name) {
+		return
+	}
+	if _, ok := seen[name.ValueString()]; ok {
+		diags.AddAttributeError(
+			p.AtName(fields.ResourceAttrName),
+			"Duplicate domain name",
+			fmt.Sprintf("Domain %q is defined more than once", name.ValueString()),
+		)
+	}
+	seen[name.ValueString()] = struct{}{}
+}
+
+// fetchSecurityConfig queries for the current security config, appending any errors seen to the provided diagnostics
+func (r *PluginSecurityConfigResource) fetchSecurityConfig(ctx context.Context, diags *diag.Diagnostics) (client.PluginSecurityConfigDynamic, bool) {
+	_, configResp, err := tryFetchSecurityConfig(ctx, r.client)
+	if err != nil {
+		if m, ok := err.(*client.APIStatusResponse); ok {
+			m.AppendDiagnostics(diags)
+		} else {
+			diags.AddError(
+				"Error querying for security config",
+				fmt.Sprintf("Error occurred querying for security config: %v", err.Error()),
+			)
+		}
+		return client.PluginSecurityConfigDynamic{}, false
+	}
+
+	return configResp.Config.Dynamic, true
+}
+
+// patchSecurityConfig applies the provided operations to the security config, appending any errors seen to the
+// provided diagnostics
+func (r *PluginSecurityConfigResource) patchSecurityConfig(ctx context.Context, ops []client.PluginSecurityConfigPatchOperation, diags *diag.Diagnostics) bool {
+	// an empty patch is a no-op
+	if len(ops) == 0 {
+		return true
+	}
+
+	// init request type
+	osReq := &client.PluginSecurityConfigPatchRequest{}
+
+	jsonB, err := json.Marshal(ops)
+	if err != nil {
+		diags.AddError(
+			"Error marshalling plan into OpenSearch request",
+			fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+		)
+		return false
+	}
+
+	// set request body
+	osReq.Body = bytes.NewReader(jsonB)
+
+	osResp, err := osReq.Do(ctx, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error updating security config",
+			fmt.Sprintf("Error executing patch security config request: %v", err),
+		)
+		return false
+	}
+
+	// create response container
+	patchResp := client.APIStatusResponse{}
+
+	// attempt to parse response
+	if err = client.ParseResponse(osResp, &patchResp, http.StatusOK); err != nil {
+		if m, ok := err.(*client.APIStatusResponse); ok {
+			m.AppendDiagnostics(diags)
+		} else {
+			diags.AddError(
+				"Error parsing patch security config response",
+				err.Error(),
+			)
+		}
+		return false
+	}
+
+	// check for errors
+	if patchResp.HasErrors() {
+		patchResp.AppendDiagnostics(diags)
+		return false
+	}
+
+	return true
+}
+
+// domainPatchOperations creates operations that add or replace each planned domain, and remove each domain created by
+// the resource that is no longer planned but still exists in the cluster
+func domainPatchOperations[T any](key string, planned map[string]T, created map[string]struct{}, remote map[string]struct{}) []client.PluginSecurityConfigPatchOperation {
+	var ops []client.PluginSecurityConfigPatchOperation
+
+	names := make([]string, 0, len(planned))
+	for name := range planned {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ops = append(ops, client.PluginSecurityConfigPatchOperation{
+			Op:    "add",
+			Path:  client.PluginSecurityConfigDynamicPath(key, name),
+			Value: planned[name],
+		})
+	}
+
+	names = names[:0]
+	for name := range created {
+		if _, ok := planned[name]; ok {
+			continue
+		}
+		if _, ok := remote[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ops = append(ops, client.PluginSecurityConfigPatchOperation{
+			Op:   "remove",
+			Path: client.PluginSecurityConfigDynamicPath(key, name),
+		})
+	}
+
+	return ops
+}
+
+// removesEveryDomain returns true if the cluster has domains and every one of them would be removed, as none are
+// planned and all were created by the resource
+func removesEveryDomain[T any](planned map[string]T, created map[string]struct{}, remote map[string]struct{}) bool {
+	if len(planned) > 0 || len(remote) == 0 {
+		return false
+	}
+	for name := range remote {
+		if _, ok := created[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// nameSet returns the provided names as a set
+func nameSet(names []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		out[name] = struct{}{}
+	}
+	return out
+}
+
+// domainNames returns the set of names of the domain blocks in a terraform list value
+func domainNames(l types.List) map[string]struct{} {
+	out := make(map[string]struct{})
+	if attributeValued(l) {
+		for _, e := range l.Elements() {
+			if o, ok := e.(types.Object); ok {
+				if name, ok := o.Attributes()[fields.ResourceAttrName].(types.String); ok && attributeValued(name) {
+					out[name.ValueString()] = struct{}{}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// buildPatch constructs the patch operations required to move the cluster config to the planned state.  Domains no
+// longer planned are only removed if they were created by the resource.
+func (r *PluginSecurityConfigResource) buildPatch(ctx context.Context, planData *PluginSecurityConfigResourceData, created pluginSecurityConfigDomainNames, remote client.PluginSecurityConfigDynamic) ([]client.PluginSecurityConfigPatchOperation, diag.Diagnostics) {
+	var (
+		ops   []client.PluginSecurityConfigPatchOperation
+		diags diag.Diagnostics
+
+		remoteAuthc  = make(map[string]struct{}, len(remote.Authc))
+		remoteAuthz  = make(map[string]struct{}, len(remote.Authz))
+		createdAuthc = nameSet(created.Authc)
+		createdAuthz = nameSet(created.Authz)
+	)
+
+	if attributeValued(planData.DoNotFailOnForbidden) {
+		ops = append(ops, client.PluginSecurityConfigPatchOperation{
+			Op:    "add",
+			Path:  client.PluginSecurityConfigDynamicPath("do_not_fail_on_forbidden"),
+			Value: planData.DoNotFailOnForbidden.ValueBool(),
+		})
+	}
+
+	ops = append(ops, terraformObjectToPatchOperations(planData.HTTP, pluginSecurityConfigHTTPPaths)...)
+	ops = append(ops, terraformObjectToPatchOperations(planData.Kibana, pluginSecurityConfigKibanaPaths)...)
+
+	for name := range remote.Authc {
+		remoteAuthc[name] = struct{}{}
+	}
+	for name := range remote.Authz {
+		remoteAuthz[name] = struct{}{}
+	}
+
+	// authc
+	authcPlan, _, d2 := domainsByName[pluginSecurityConfigAuthcDomainData](ctx, planData.Authc)
+	if diags.Append(d2...); diags.HasError() {
+		return nil, diags
+	}
+	authc := make(map[string]client.PluginSecurityConfigAuthcDomain, len(authcPlan))
+	for name, d := range authcPlan {
+		authc[name] = terraformAuthcDomainToAuthcDomain(d)
+	}
+	if removesEveryDomain(authc, createdAuthc, remoteAuthc) {
+		diags.AddAttributeError(
+			path.Root(fields.ResourceAttrAuthc),
+			"Refusing to remove every authentication domain",
+			"This change would remove every authc domain from the cluster, leaving no way to authenticate.  Define at"+
+				" least one authc domain.",
+		)
+		return nil, diags
+	}
+	ops = append(ops, domainPatchOperations("authc", authc, createdAuthc, remoteAuthc)...)
+
+	// authz
+	authzPlan, _, d2 := domainsByName[pluginSecurityConfigAuthzDomainData](ctx, planData.Authz)
+	if diags.Append(d2...); diags.HasError() {
+		return nil, diags
+	}
+	authz := make(map[string]client.PluginSecurityConfigAuthzDomain, len(authzPlan))
+	for name, d := range authzPlan {
+		authz[name] = terraformAuthzDomainToAuthzDomain(d)
+	}
+	ops = append(ops, domainPatchOperations("authz", authz, createdAuthz, remoteAuthz)...)
+
+	return ops, diags
+}
+
+// applyPlan patches the cluster config with the known plan values and updates the provided model with what the
+// cluster reports afterwards.  The domains created by the resource after the patch are returned.
+func (r *PluginSecurityConfigResource) applyPlan(ctx context.Context, planData *PluginSecurityConfigResourceData, created pluginSecurityConfigDomainNames, diags *diag.Diagnostics) pluginSecurityConfigDomainNames {
+	// fetch current config to determine which domains exist
+	conf, ok := r.fetchSecurityConfig(ctx, diags)
+	if !ok {
+		return created
+	}
+
+	ops, opDiags := r.buildPatch(ctx, planData, created, conf)
+	if diags.Append(opDiags...); diags.HasError() {
+		return created
+	}
+
+	// planned domains that do not exist yet are about to be created
+	remoteAuthc := make(map[string]struct{}, len(conf.Authc))
+	for name := range conf.Authc {
+		remoteAuthc[name] = struct{}{}
+	}
+	remoteAuthz := make(map[string]struct{}, len(conf.Authz))
+	for name := range conf.Authz {
+		remoteAuthz[name] = struct{}{}
+	}
+	created = pluginSecurityConfigDomainNames{
+		Authc: createdDomainNames(domainNames(planData.Authc), created.Authc, remoteAuthc),
+		Authz: createdDomainNames(domainNames(planData.Authz), created.Authz, remoteAuthz),
+	}
+
+	// persist
+	if !r.patchSecurityConfig(ctx, ops, diags) {
+		return created
+	}
+
+	// refresh from cluster
+	if conf, ok = r.fetchSecurityConfig(ctx, diags); !ok {
+		return created
+	}
+
+	diags.Append(planData.UpdateFromSecurityConfig(ctx, conf, pluginSecurityConfigDomainNames{})...)
+	return created
+}
+
+func (r *PluginSecurityConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		planData = new(PluginSecurityConfigResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	created := r.applyPlan(ctx, planData, pluginSecurityConfigDomainNames{}, &resp.Diagnostics)
+	setPluginSecurityConfigCreatedDomains(ctx, resp.Private, created, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		stateData = new(PluginSecurityConfigResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	conf, ok := r.fetchSecurityConfig(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// update data object from cluster config
+	resp.Diagnostics.Append(stateData.UpdateFromSecurityConfig(ctx, conf, pluginSecurityConfigDomainNames{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
+
+func (r *PluginSecurityConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		planData  = new(PluginSecurityConfigResourceData)
+		stateData = new(PluginSecurityConfigResourceData)
+	)
+
+	// marshal plan and state values into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	created := getPluginSecurityConfigCreatedDomains(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created = r.applyPlan(ctx, planData, created, &resp.Diagnostics)
+	setPluginSecurityConfigCreatedDomains(ctx, resp.Private, created, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		stateData = new(PluginSecurityConfigResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the entire operation by its timeout
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	created := getPluginSecurityConfigCreatedDomains(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conf, ok := r.fetchSecurityConfig(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// only the domains created by this resource are removed.  imported and adopted domains, and all other settings,
+	// are left as-is.
+	ops, opDiags := deleteDomainPatchOperations(created, conf)
+	resp.Diagnostics.Append(opDiags...)
+
+	r.patchSecurityConfig(ctx, ops, &resp.Diagnostics)
+}
+
+// deleteDomainPatchOperations creates operations that remove each domain created by the resource which still exists
+// in the cluster.  authc domains are retained, with a warning, if removing them would leave none.
+func deleteDomainPatchOperations(created pluginSecurityConfigDomainNames, remote client.PluginSecurityConfigDynamic) ([]client.PluginSecurityConfigPatchOperation, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+
+		remoteAuthc  = make(map[string]struct{}, len(remote.Authc))
+		remoteAuthz  = make(map[string]struct{}, len(remote.Authz))
+		createdAuthc = nameSet(created.Authc)
+	)
+
+	for name := range remote.Authc {
+		remoteAuthc[name] = struct{}{}
+	}
+	for name := range remote.Authz {
+		remoteAuthz[name] = struct{}{}
+	}
+
+	if removesEveryDomain(map[string]struct{}{}, createdAuthc, remoteAuthc) {
+		diags.AddWarning(
+			"Authentication domains retained",
+			fmt.Sprintf(
+				"Removing the authc domains created by this resource (%s) would leave the cluster with no way to"+
+					" authenticate, so they have been left in place.",
+				strings.Join(created.Authc, ", "),
+			),
+		)
+		createdAuthc = nil
+	}
+
+	ops := domainPatchOperations("authc", map[string]struct{}{}, createdAuthc, remoteAuthc)
+	ops = append(ops, domainPatchOperations("authz", map[string]struct{}{}, nameSet(created.Authz), remoteAuthz)...)
+	return ops, diags
+}
+
+func (r *PluginSecurityConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		stateData = new(PluginSecurityConfigResourceData)
+	)
+
+	// determine which domains to import
+	include, err := parsePluginSecurityConfigImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Import id %q is invalid: %v", req.ID, err),
+		)
+		return
+	}
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	ctx, cancel := context.WithDeadline(r.operationContext(ctx, operationImport), r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics))
+	defer cancel()
+
+	conf, ok := r.fetchSecurityConfig(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// each named domain must exist
+	for _, name := range include.Authc {
+		if _, ok := conf.Authc[name]; !ok {
+			resp.Diagnostics.AddError("Domain not found", fmt.Sprintf("authc domain %q not found in security config", name))
+		}
+	}
+	for _, name := range include.Authz {
+		if _, ok := conf.Authz[name]; !ok {
+			resp.Diagnostics.AddError("Domain not found", fmt.Sprintf("authz domain %q not found in security config", name))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update data object from cluster config, importing only the named domains.  these were not created by this
+	// resource, so are never removed by it.
+	resp.Diagnostics.Append(stateData.UpdateFromSecurityConfig(ctx, conf, include)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
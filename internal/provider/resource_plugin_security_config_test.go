@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityConfig(t *testing.T) {
+	const (
+		resourceName = "test_security_config"
+		domainName   = "test_proxy_auth_domain"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginConfig, resourceName)
+	)
+
+	t.Run("multiple-authenticators-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.AppendBlocks(
+							acctest.PluginSecurityConfigConfigWith(resourceName),
+							acctest.AppendBlocks(
+								acctest.Block(fields.ResourceAttrAuthc, map[string]interface{}{
+									fields.ResourceAttrName:  domainName,
+									fields.ResourceAttrOrder: 10,
+								}),
+								acctest.Block(fields.ResourceAttrBasic),
+								acctest.Block(fields.ResourceAttrProxy, map[string]interface{}{
+									fields.ResourceAttrUserHeader: "x-proxy-user",
+								}),
+							),
+						),
+					),
+					ExpectError: regexp.MustCompile("Exactly one of"),
+				},
+			},
+		})
+	})
+
+	t.Run("duplicate-domain-names-throws-error", func(t *testing.T) {
+		domain := acctest.AppendBlocks(
+			acctest.Block(fields.ResourceAttrAuthc, map[string]interface{}{
+				fields.ResourceAttrName:  domainName,
+				fields.ResourceAttrOrder: 10,
+			}),
+			acctest.Block(fields.ResourceAttrProxy, map[string]interface{}{
+				fields.ResourceAttrUserHeader: "x-proxy-user",
+			}),
+		)
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.AppendBlocks(acctest.PluginSecurityConfigConfigWith(resourceName), domain, domain),
+					),
+					ExpectError: regexp.MustCompile("Duplicate domain name"),
+				},
+			},
+		})
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.AppendBlocks(
+							acctest.PluginSecurityConfigConfigWith(resourceName),
+							acctest.AppendBlocks(
+								acctest.Block(fields.ResourceAttrAuthc, map[string]interface{}{
+									fields.ResourceAttrName:  domainName,
+									fields.ResourceAttrOrder: 10,
+								}),
+								acctest.Block(fields.ResourceAttrProxy, map[string]interface{}{
+									fields.ResourceAttrUserHeader:  "x-proxy-user",
+									fields.ResourceAttrRolesHeader: "x-proxy-roles",
+								}),
+							),
+						),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0.%s", fields.ResourceAttrAuthc, fields.ResourceAttrName),
+							domainName,
+						),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0.%s", fields.ResourceAttrAuthc, fields.ResourceAttrAuthenticationBackend),
+							"noop",
+						),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0.%s.%s", fields.ResourceAttrAuthc, fields.ResourceAttrProxy, fields.ResourceAttrUserHeader),
+							"x-proxy-user",
+						),
+						resource.TestCheckResourceAttrSet(
+							resourceFQN,
+							fmt.Sprintf("%s.%s", fields.ResourceAttrKibana, fields.ResourceAttrMultitenancyEnabled),
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestUnit_ParsePluginSecurityConfigImportID(t *testing.T) {
+	for id, tc := range map[string]struct {
+		names pluginSecurityConfigDomainNames
+		err   bool
+	}{
+		"":       {},
+		"config": {},
+		"config,authc:basic_internal_auth_domain,authz:roles_from_myldap": {
+			names: pluginSecurityConfigDomainNames{Authc: []string{"basic_internal_auth_domain"}, Authz: []string{"roles_from_myldap"}},
+		},
+		"authc:a, authc:b": {names: pluginSecurityConfigDomainNames{Authc: []string{"a", "b"}}},
+		"config,authc":     {err: true},
+		"config,other:a":   {err: true},
+	} {
+		names, err := parsePluginSecurityConfigImportID(id)
+		if (err != nil) != tc.err {
+			t.Errorf("%q: expected error %t, saw %v", id, tc.err, err)
+		} else if !tc.err && !reflect.DeepEqual(names, tc.names) {
+			t.Errorf("%q: expected %+v, saw %+v", id, tc.names, names)
+		}
+	}
+}
+
+func TestUnit_PluginSecurityConfigDomainRemoval(t *testing.T) {
+	remote := client.PluginSecurityConfigDynamic{
+		Authc: map[string]client.PluginSecurityConfigAuthcDomain{
+			"basic_internal_auth_domain": {},
+			"managed":                    {},
+		},
+	}
+
+	t.Run("created-domains-are-tracked", func(t *testing.T) {
+		planned := map[string]struct{}{"basic_internal_auth_domain": {}, "managed": {}, "new": {}}
+		remoteNames := map[string]struct{}{"basic_internal_auth_domain": {}, "managed": {}}
+		if created := createdDomainNames(planned, []string{"managed"}, remoteNames); !reflect.DeepEqual(created, []string{"managed", "new"}) {
+			t.Errorf("unexpected created domains: %v", created)
+		}
+	})
+
+	t.Run("delete-removes-only-created", func(t *testing.T) {
+		ops, diags := deleteDomainPatchOperations(pluginSecurityConfigDomainNames{Authc: []string{"managed"}}, remote)
+		if diags.HasError() || diags.WarningsCount() > 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if len(ops) != 1 || ops[0].Op != "remove" || ops[0].Path != client.PluginSecurityConfigDynamicPath("authc", "managed") {
+			t.Errorf("unexpected operations: %+v", ops)
+		}
+	})
+
+	t.Run("delete-imported-removes-nothing", func(t *testing.T) {
+		if ops, _ := deleteDomainPatchOperations(pluginSecurityConfigDomainNames{}, remote); len(ops) != 0 {
+			t.Errorf("expected no operations, saw %+v", ops)
+		}
+	})
+
+	t.Run("delete-never-removes-last-authc", func(t *testing.T) {
+		ops, diags := deleteDomainPatchOperations(pluginSecurityConfigDomainNames{Authc: []string{"basic_internal_auth_domain", "managed"}}, remote)
+		if len(ops) != 0 {
+			t.Errorf("expected no operations, saw %+v", ops)
+		}
+		if diags.WarningsCount() != 1 {
+			t.Errorf("expected a warning, saw %v", diags)
+		}
+	})
+}
+
+func TestUnit_PluginSecurityConfigDomainBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	proxy := func(order int64) client.PluginSecurityConfigAuthcDomain {
+		return client.PluginSecurityConfigAuthcDomain{
+			HTTPEnabled:           true,
+			Order:                 order,
+			HTTPAuthenticator:     client.PluginSecurityConfigHTTPAuthenticator{Type: "proxy", Config: map[string]interface{}{"user_header": "x-proxy-user"}},
+			AuthenticationBackend: client.PluginSecurityConfigBackend{Type: "noop"},
+		}
+	}
+	remote := client.PluginSecurityConfigDynamic{
+		Authc: map[string]client.PluginSecurityConfigAuthcDomain{
+			"a": proxy(1),
+			"b": proxy(2),
+			"c": proxy(3),
+			"d": proxy(4),
+		},
+	}
+
+	authcNames := func(t *testing.T, data *PluginSecurityConfigResourceData) []string {
+		t.Helper()
+		domains, names, diags := domainsByName[pluginSecurityConfigAuthcDomainData](ctx, data.Authc)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		for name, d := range domains {
+			if d.Proxy.IsNull() {
+				t.Errorf("domain %q has no proxy authenticator", name)
+			}
+		}
+		return names
+	}
+
+	t.Run("no-domains-is-empty-list", func(t *testing.T) {
+		data := new(PluginSecurityConfigResourceData)
+		if diags := data.UpdateFromSecurityConfig(ctx, remote, pluginSecurityConfigDomainNames{}); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		// absent blocks are planned as empty lists, so state must match
+		if data.Authc.IsNull() || len(data.Authc.Elements()) != 0 {
+			t.Errorf("expected empty authc list, saw %v", data.Authc)
+		}
+		if data.Authz.IsNull() || len(data.Authz.Elements()) != 0 {
+			t.Errorf("expected empty authz list, saw %v", data.Authz)
+		}
+	})
+
+	t.Run("block-order-is-kept", func(t *testing.T) {
+		data := new(PluginSecurityConfigResourceData)
+		if diags := data.UpdateFromSecurityConfig(ctx, remote, pluginSecurityConfigDomainNames{Authc: []string{"b", "a", "missing"}}); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if names := authcNames(t, data); !reflect.DeepEqual(names, []string{"b", "a"}) {
+			t.Fatalf("expected imported domains in the order named, saw %v", names)
+		}
+
+		// domains named by later imports follow those already in state
+		if diags := data.UpdateFromSecurityConfig(ctx, remote, pluginSecurityConfigDomainNames{Authc: []string{"c", "a"}}); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if names := authcNames(t, data); !reflect.DeepEqual(names, []string{"b", "a", "c"}) {
+			t.Errorf("expected block order to be kept, saw %v", names)
+		}
+		if names := domainNames(data.Authc); !reflect.DeepEqual(names, map[string]struct{}{"a": {}, "b": {}, "c": {}}) {
+			t.Errorf("unexpected domain names: %v", names)
+		}
+	})
+}
@@ -0,0 +1,568 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewPluginSecurityNodesDNResource() resource.Resource {
+	r := new(PluginSecurityNodesDNResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginNodesDN)
+	r.minimumVersion = minimumPluginSecurityVersion
+	return r
+}
+
+type PluginSecurityNodesDNResource struct {
+	ResourceShared
+}
+
+type PluginSecurityNodesDNResourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	ClusterName types.String `tfsdk:"cluster_name"`
+	NodesDN     types.List   `tfsdk:"nodes_dn"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (d *PluginSecurityNodesDNResourceData) UpdateFromNodesDN(clusterName string, n client.PluginSecurityNodesDN) diag.Diagnostics {
+	d.ClusterName = types.StringValue(clusterName)
+
+	// set id to cluster name so framework is happy
+	d.ID = d.ClusterName
+
+	d.NodesDN = conv.StringsToStringList(n.NodesDN, false)
+
+	return diag.Diagnostics{}
+}
+
+func (r *PluginSecurityNodesDNResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.ResourceTypeSecurityPluginNodesDN)
+}
+
+func (r *PluginSecurityNodesDNResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin Nodes DN.  Defines the distinguished names of nodes allowed to join from" +
+			" a remote cluster.  Requires plugins.security.nodes_dn_dynamic_config_enabled to be set on the cluster.",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrClusterName: schema.StringAttribute{
+				Description: "Name of the remote cluster",
+				Required:    true,
+				Validators: []validator.String{
+					validation.Required(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			fields.ResourceAttrNodesDN: schema.ListAttribute{
+				Description: "Distinguished names of the nodes allowed to join, wildcards and regular expressions are supported",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *PluginSecurityNodesDNResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		clusterName string
+		osNodesDN   client.PluginSecurityNodesDN
+		ok          bool
+
+		planData = new(PluginSecurityNodesDNResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+
+	// extract cluster name
+	clusterName = planData.ClusterName.ValueString()
+
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchNodesDN(ctx, r.client, clusterName)
+		if err == nil {
+			resp.Diagnostics.AddError(
+				"Nodes DN already exists",
+				fmt.Sprintf("Nodes DN for cluster %q already exists in cluster", clusterName),
+			)
+			return
+		}
+		// anything other than not found means existence could not be determined, so the create is not attempted
+		if !client.IsNotFound(err) {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for nodes dn",
+					fmt.Sprintf("Error occurred looking for existing nodes dn for cluster %q: %v", clusterName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute create request
+	{
+		// init request type
+		osReq := &client.PluginSecurityNodesDNUpsertRequest{
+			Name: clusterName,
+		}
+
+		// convert plan data to opensearch model
+		osNodesDN = terraformSecurityNodesDNToSecurityNodesDN(planData)
+
+		jsonB, err := json.Marshal(osNodesDN)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		// execute create call
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating nodes dn",
+				fmt.Sprintf("Error executing create nodes dn request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		createResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &createResp, http.StatusCreated); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing create nodes dn response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if createResp.HasErrors() {
+			createResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+
+		// check for warnings
+		if len(createResp.WarningsHeader) > 0 {
+			for _, w := range createResp.WarningsHeader {
+				resp.Diagnostics.AddWarning(
+					w,
+					fmt.Sprintf("Warning received after creating nodes dn for cluster %q: %v", clusterName, w),
+				)
+			}
+		}
+	}
+
+	// attempt to fetch newly created nodes dn
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osNodesDNs, err := tryFetchNodesDN(ctx, r.client, clusterName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching newly created nodes dn",
+				fmt.Sprintf("Error fetching newly created nodes dn for cluster %q: %v", clusterName, err.Error()),
+			)
+			return
+		}
+		if osNodesDN, ok = osNodesDNs[clusterName]; !ok {
+			resp.Diagnostics.AddError(
+				"Nodes DN not found",
+				fmt.Sprintf("Unable to locate newly created nodes dn for cluster %q", clusterName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromNodesDN(clusterName, osNodesDN)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityNodesDNResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		clusterName string
+		osNodesDN   client.PluginSecurityNodesDN
+		updateDiags diag.Diagnostics
+		ok          bool
+
+		stateData = new(PluginSecurityNodesDNResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+
+	// extract cluster name
+	clusterName = stateData.ClusterName.ValueString()
+
+	// query for nodes dn from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osNodesDNs, err := tryFetchNodesDN(ctx, r.client, clusterName)
+		if err != nil {
+			// nodes dn was deleted outside of terraform, remove from state so the next plan recreates it
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for nodes dn",
+					fmt.Sprintf("Error occurred querying for nodes dn for cluster %q: %v", clusterName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract nodes dn from response, treating absence as drift
+		if osNodesDN, ok = osNodesDNs[clusterName]; !ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	// update data object from source nodes dn
+	updateDiags = stateData.UpdateFromNodesDN(clusterName, osNodesDN)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
+
+func (r *PluginSecurityNodesDNResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		clusterName string
+		osNodesDN   client.PluginSecurityNodesDN
+		ok          bool
+
+		planData = new(PluginSecurityNodesDNResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+
+	// extract cluster name
+	clusterName = planData.ClusterName.ValueString()
+
+	// attempt to locate nodes dn in cluster
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchNodesDN(ctx, r.client, clusterName)
+		if err != nil {
+			if client.IsNotFound(err) {
+				// if the nodes dn was not found, prevent the update call from creating a new one.
+				resp.Diagnostics.AddError(
+					"Nodes DN not found",
+					fmt.Sprintf("Nodes DN for cluster %q was not found in cluster", clusterName),
+				)
+			} else if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for nodes dn",
+					fmt.Sprintf("Error occurred querying for nodes dn for cluster %q: %v", clusterName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute update call
+	{
+		// init request type
+		osReq := &client.PluginSecurityNodesDNUpsertRequest{
+			Name: clusterName,
+		}
+
+		// convert plan data to opensearch model
+		osNodesDN = terraformSecurityNodesDNToSecurityNodesDN(planData)
+
+		jsonB, err := json.Marshal(osNodesDN)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating nodes dn",
+				fmt.Sprintf("Error executing update nodes dn request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		updateResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &updateResp, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing update nodes dn response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if updateResp.HasErrors() {
+			updateResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+
+	// attempt to fetch updated nodes dn
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osNodesDNs, err := tryFetchNodesDN(ctx, r.client, clusterName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching updated nodes dn",
+				fmt.Sprintf("Error fetching updated nodes dn for cluster %q: %v", clusterName, err.Error()),
+			)
+			return
+		}
+		if osNodesDN, ok = osNodesDNs[clusterName]; !ok {
+			resp.Diagnostics.AddError(
+				"Nodes DN not found",
+				fmt.Sprintf("Unable to locate updated nodes dn for cluster %q", clusterName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromNodesDN(clusterName, osNodesDN)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityNodesDNResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		clusterName string
+
+		stateData = new(PluginSecurityNodesDNResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+
+	// extract cluster name
+	clusterName = stateData.ClusterName.ValueString()
+
+	// execute delete call
+	{
+		osReq := &client.PluginSecurityNodesDNDeleteRequest{
+			Name: clusterName,
+		}
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error deleting nodes dn",
+					fmt.Sprintf("Error occurred deleting nodes dn for cluster %q: %v", clusterName, err),
+				)
+			}
+			return
+		}
+
+		// attempt to parse response
+		sink := client.APIStatusResponse{}
+		if err = client.ParseResponse(osResp, &sink, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing delete nodes dn response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		if sink.HasErrors() {
+			sink.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+}
+
+func (r *PluginSecurityNodesDNResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		clusterName string
+		osNodesDN   client.PluginSecurityNodesDN
+		updateDiags diag.Diagnostics
+		ok          bool
+
+		stateData = new(PluginSecurityNodesDNResourceData)
+	)
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	ctx = r.operationContext(ctx, operationImport)
+
+	// extract cluster name
+	clusterName = req.ID
+
+	// query for nodes dn from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osNodesDNs, err := tryFetchNodesDN(ctx, r.client, clusterName)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for nodes dn",
+					fmt.Sprintf("Error occurred querying for nodes dn for cluster %q: %v", clusterName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract nodes dn from response
+		if osNodesDN, ok = osNodesDNs[clusterName]; !ok {
+			resp.Diagnostics.AddError(
+				"Nodes DN not found",
+				fmt.Sprintf("Nodes DN for cluster %q not found", clusterName),
+			)
+			return
+		}
+	}
+
+	// update data object from source nodes dn
+	updateDiags = stateData.UpdateFromNodesDN(clusterName, osNodesDN)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
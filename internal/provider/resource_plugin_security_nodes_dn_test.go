@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityNodesDN(t *testing.T) {
+	const (
+		resourceName = "test_nodes_dn"
+		renamed      = resourceName + "_renamed"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginNodesDN, resourceName)
+	)
+
+	t.Run("empty-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityNodesDNConfigWith(resourceName, nil),
+					),
+					ExpectError: regexp.MustCompile("required"),
+				},
+			},
+		})
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		const (
+			nodeDN = "CN=node1.example.com,OU=SSL,O=Test,L=Test,C=DE"
+		)
+
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityNodesDNValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrNodesDN: []string{nodeDN},
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrClusterName, resourceName),
+						resource.TestCheckResourceAttr(resourceFQN, fmt.Sprintf("%s.0", fields.ResourceAttrNodesDN), nodeDN),
+					),
+				},
+				{
+					ResourceName:      resourceFQN,
+					ImportState:       true,
+					ImportStateId:     resourceName,
+					ImportStateVerify: true,
+				},
+				// renaming replaces the cluster's entry, as the old name cannot be updated in place
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityNodesDNValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrClusterName: renamed,
+							fields.ResourceAttrNodesDN:     []string{nodeDN},
+						}),
+					),
+					Check: resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrClusterName, renamed),
+				},
+			},
+		})
+	})
+}
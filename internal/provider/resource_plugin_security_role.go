@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
 	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
@@ -16,12 +15,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func NewPluginSecurityRoleResource() resource.Resource {
 	r := new(PluginSecurityRoleResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginRole)
+	r.minimumVersion = minimumPluginSecurityVersion
 	return r
 }
 
@@ -41,6 +43,8 @@ type PluginSecurityRoleResourceData struct {
 	Reserved types.Bool `tfsdk:"reserved"`
 	Hidden   types.Bool `tfsdk:"hidden"`
 	Static   types.Bool `tfsdk:"static"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 func (d *PluginSecurityRoleResourceData) UpdateFromRole(roleName string, r client.PluginSecurityRole) diag.Diagnostics {
@@ -86,6 +90,9 @@ func (r *PluginSecurityRoleResource) Schema(_ context.Context, _ resource.Schema
 				Validators: []validator.String{
 					validation.Required(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			fields.ResourceAttrDescription: schema.StringAttribute{
 				Optional: true,
@@ -156,6 +163,9 @@ func (r *PluginSecurityRoleResource) Schema(_ context.Context, _ resource.Schema
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
 	}
 }
 
@@ -164,7 +174,6 @@ func (r *PluginSecurityRoleResource) Create(ctx context.Context, req resource.Cr
 		roleName string
 		osRole   client.PluginSecurityRole
 		ok       bool
-		err      error
 
 		planData = new(PluginSecurityRoleResourceData)
 	)
@@ -175,29 +184,31 @@ func (r *PluginSecurityRoleResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+
 	// extract role name
 	roleName = planData.RoleName.ValueString()
 
 	{
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
-		psResp, _, _ := tryFetchRoles(ctx, r.client, roleName)
-
-		if psResp != nil {
-			// if we got some kind of response from opensearch, test status code
-			if psResp.StatusCode == 200 {
-				resp.Diagnostics.AddError(
-					"Role already exists",
-					fmt.Sprintf("Role %q already exists in cluster", roleName),
-				)
-				return
-			}
-			// if we get here, assume that the role either does not already exists, or some kind of permission
-			// error occurred at this point, allow create attempt to happen.
-		} else if err != nil {
-			// if an error was seen, assume big badness
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+		_, _, err := tryFetchRoles(ctx, r.client, roleName)
+		if err == nil {
+			resp.Diagnostics.AddError(
+				"Role already exists",
+				fmt.Sprintf("Role %q already exists in cluster", roleName),
+			)
+			return
+		}
+		// anything other than not found means existence could not be determined, so the create is not attempted
+		if !client.IsNotFound(err) {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error querying for role",
@@ -231,7 +242,7 @@ func (r *PluginSecurityRoleResource) Create(ctx context.Context, req resource.Cr
 		osReq.Body = bytes.NewReader(jsonB)
 
 		// execute create call
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 		osResp, err := osReq.Do(ctx, r.client)
 		if err != nil {
@@ -247,8 +258,8 @@ func (r *PluginSecurityRoleResource) Create(ctx context.Context, req resource.Cr
 
 		// attempt to parse response
 		if err = client.ParseResponse(osResp, &createResp, http.StatusCreated); err != nil {
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error parsing create role response",
@@ -260,7 +271,7 @@ func (r *PluginSecurityRoleResource) Create(ctx context.Context, req resource.Cr
 
 		// check for errors
 		if createResp.HasErrors() {
-			createResp.AppendDiagnostics(resp.Diagnostics)
+			createResp.AppendDiagnostics(&resp.Diagnostics)
 			return
 		}
 
@@ -277,7 +288,7 @@ func (r *PluginSecurityRoleResource) Create(ctx context.Context, req resource.Cr
 
 	// attempt to fetch newly created role
 	{
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 		_, osRoles, err := tryFetchRoles(ctx, r.client, roleName)
 		if err != nil {
@@ -322,18 +333,30 @@ func (r *PluginSecurityRoleResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+
 	// extract role name
 	roleName = stateData.RoleName.ValueString()
 
 	// query for role from cluster
 	// done in sub-context to avoid poisoning ctx var
 	{
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 		_, osRoles, err := tryFetchRoles(ctx, r.client, roleName)
 		if err != nil {
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+			// role was deleted outside of terraform, remove from state so the next plan recreates it
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error querying for role",
@@ -343,12 +366,9 @@ func (r *PluginSecurityRoleResource) Read(ctx context.Context, req resource.Read
 			return
 		}
 
-		// attempt to extract role from response
+		// attempt to extract role from response, treating absence as drift
 		if osRole, ok = osRoles[roleName]; !ok {
-			resp.Diagnostics.AddError(
-				"Role not found",
-				fmt.Sprintf("Role %q not found", roleName),
-			)
+			resp.State.RemoveResource(ctx)
 			return
 		}
 	}
@@ -382,17 +402,30 @@ func (r *PluginSecurityRoleResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+
 	// extract role name
 	roleName = planData.RoleName.ValueString()
 
 	// attempt to locate role in cluster
 	{
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
-		psResp, _, err := tryFetchRoles(ctx, r.client, roleName)
+		_, _, err := tryFetchRoles(ctx, r.client, roleName)
 		if err != nil {
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+			if client.IsNotFound(err) {
+				// if the role was not found, prevent the update call from creating a new one.
+				resp.Diagnostics.AddError(
+					"Role not found",
+					fmt.Sprintf("Role %q was not found in cluster", roleName),
+				)
+			} else if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error querying for role",
@@ -401,15 +434,6 @@ func (r *PluginSecurityRoleResource) Update(ctx context.Context, req resource.Up
 			}
 			return
 		}
-
-		// if the role was not found, prevent the update call from creating a new one.
-		if psResp.StatusCode != 200 {
-			resp.Diagnostics.AddError(
-				"Role not found",
-				fmt.Sprintf("Role %q was not found in cluster", roleName),
-			)
-			return
-		}
 	}
 
 	// execute update call
@@ -434,7 +458,7 @@ func (r *PluginSecurityRoleResource) Update(ctx context.Context, req resource.Up
 		// set request body
 		osReq.Body = bytes.NewReader(jsonB)
 
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 		osResp, err := osReq.Do(ctx, r.client)
 		if err != nil {
@@ -447,8 +471,8 @@ func (r *PluginSecurityRoleResource) Update(ctx context.Context, req resource.Up
 
 		// attempt to parse response
 		if err = client.ParseResponse(osResp, &osRole, http.StatusOK); err != nil {
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error parsing create role response",
@@ -482,6 +506,13 @@ func (r *PluginSecurityRoleResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+
 	// extract role name
 	roleName = planData.RoleName.ValueString()
 
@@ -491,12 +522,12 @@ func (r *PluginSecurityRoleResource) Delete(ctx context.Context, req resource.De
 			Name: roleName,
 		}
 
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 		osResp, err := osReq.Do(ctx, r.client)
 		if err != nil {
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error deleting role",
@@ -509,8 +540,8 @@ func (r *PluginSecurityRoleResource) Delete(ctx context.Context, req resource.De
 		// attempt to parse response
 		sink := client.APIStatusResponse{}
 		if err = client.ParseResponse(osResp, &sink, http.StatusOK); err != nil {
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error parsing create role response",
@@ -521,7 +552,7 @@ func (r *PluginSecurityRoleResource) Delete(ctx context.Context, req resource.De
 		}
 
 		if sink.HasErrors() {
-			sink.AppendDiagnostics(resp.Diagnostics)
+			sink.AppendDiagnostics(&resp.Diagnostics)
 			return
 		}
 	}
@@ -537,18 +568,23 @@ func (r *PluginSecurityRoleResource) ImportState(ctx context.Context, req resour
 		stateData = new(PluginSecurityRoleResourceData)
 	)
 
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	ctx = r.operationContext(ctx, operationImport)
+
 	// extract role name
 	roleName = req.ID
 
 	// query for role from cluster
 	// done in sub-context to avoid poisoning ctx var
 	{
-		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctx, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 		_, osRoles, err := tryFetchRoles(ctx, r.client, roleName)
 		if err != nil {
-			if m, ok := err.(client.APIStatusResponse); ok {
-				m.AppendDiagnostics(resp.Diagnostics)
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
 			} else {
 				resp.Diagnostics.AddError(
 					"Error querying for role",
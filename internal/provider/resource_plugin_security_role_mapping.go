@@ -0,0 +1,610 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewPluginSecurityRoleMappingResource() resource.Resource {
+	r := new(PluginSecurityRoleMappingResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginRoleMapping)
+	r.minimumVersion = minimumPluginSecurityVersion
+	return r
+}
+
+type PluginSecurityRoleMappingResource struct {
+	ResourceShared
+}
+
+type PluginSecurityRoleMappingResourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	RoleName        types.String `tfsdk:"role_name"`
+	Description     types.String `tfsdk:"description"`
+	BackendRoles    types.List   `tfsdk:"backend_roles"`
+	AndBackendRoles types.List   `tfsdk:"and_backend_roles"`
+	Hosts           types.List   `tfsdk:"hosts"`
+	Users           types.List   `tfsdk:"users"`
+
+	Reserved types.Bool `tfsdk:"reserved"`
+	Hidden   types.Bool `tfsdk:"hidden"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (d *PluginSecurityRoleMappingResourceData) UpdateFromRoleMapping(roleName string, m client.PluginSecurityRoleMapping) diag.Diagnostics {
+	d.RoleName = types.StringValue(roleName)
+
+	// set id to role name so framework is happy
+	d.ID = d.RoleName
+
+	d.Description = types.StringValue(m.Description)
+	d.BackendRoles = conv.StringsToStringList(m.BackendRoles, true)
+	d.AndBackendRoles = conv.StringsToStringList(m.AndBackendRoles, true)
+	d.Hosts = conv.StringsToStringList(m.Hosts, true)
+	d.Users = conv.StringsToStringList(m.Users, true)
+
+	// set "computed" values
+	d.Hidden = conv.BoolPtrToBoolValue(m.Hidden)
+	d.Reserved = conv.BoolPtrToBoolValue(m.Reserved)
+
+	return diag.Diagnostics{}
+}
+
+func (r *PluginSecurityRoleMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.ResourceTypeSecurityPluginRoleMapping)
+}
+
+func (r *PluginSecurityRoleMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin Role Mapping",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrRoleName: schema.StringAttribute{
+				Description: "Name of the role to map",
+				Required:    true,
+				Validators: []validator.String{
+					validation.Required(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			fields.ResourceAttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					defaultValuedStringPlanModifier(""),
+				},
+			},
+			fields.ResourceAttrBackendRoles: schema.ListAttribute{
+				Description: "Backend roles mapped to the role",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrAndBackendRoles: schema.ListAttribute{
+				Description: "Backend roles that must all be present for the role to be mapped",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrHosts: schema.ListAttribute{
+				Description: "Hosts mapped to the role",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrUsers: schema.ListAttribute{
+				Description: "Users mapped to the role",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrHidden: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrReserved: schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *PluginSecurityRoleMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		roleName  string
+		osMapping client.PluginSecurityRoleMapping
+		ok        bool
+
+		planData = new(PluginSecurityRoleMappingResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+
+	// extract role name
+	roleName = planData.RoleName.ValueString()
+
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchRoleMappings(ctx, r.client, roleName)
+		if err == nil {
+			resp.Diagnostics.AddError(
+				"Role mapping already exists",
+				fmt.Sprintf("Role mapping %q already exists in cluster", roleName),
+			)
+			return
+		}
+		// anything other than not found means existence could not be determined, so the create is not attempted
+		if !client.IsNotFound(err) {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for role mapping",
+					fmt.Sprintf("Error occurred looking for existing role mapping %q: %v", roleName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute create request
+	{
+		// init request type
+		osReq := &client.PluginSecurityRoleMappingUpsertRequest{
+			Name: roleName,
+		}
+
+		// convert plan data to opensearch model
+		osMapping = terraformSecurityRoleMappingToSecurityRoleMapping(planData)
+
+		jsonB, err := json.Marshal(osMapping)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		// execute create call
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating role mapping",
+				fmt.Sprintf("Error executing create role mapping request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		createResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &createResp, http.StatusCreated); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing create role mapping response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if createResp.HasErrors() {
+			createResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+
+		// check for warnings
+		if len(createResp.WarningsHeader) > 0 {
+			for _, w := range createResp.WarningsHeader {
+				resp.Diagnostics.AddWarning(
+					w,
+					fmt.Sprintf("Warning received after creating role mapping %q: %v", roleName, w),
+				)
+			}
+		}
+	}
+
+	// attempt to fetch newly created role mapping
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osMappings, err := tryFetchRoleMappings(ctx, r.client, roleName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching newly created role mapping",
+				fmt.Sprintf("Error fetching newly created role mapping %q: %v", roleName, err.Error()),
+			)
+			return
+		}
+		if osMapping, ok = osMappings[roleName]; !ok {
+			resp.Diagnostics.AddError(
+				"Role mapping not found",
+				fmt.Sprintf("Unable to locate newly created role mapping %q", roleName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromRoleMapping(roleName, osMapping)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityRoleMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		roleName    string
+		osMapping   client.PluginSecurityRoleMapping
+		updateDiags diag.Diagnostics
+		ok          bool
+
+		stateData = new(PluginSecurityRoleMappingResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+
+	// extract role name
+	roleName = stateData.RoleName.ValueString()
+
+	// query for role mapping from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osMappings, err := tryFetchRoleMappings(ctx, r.client, roleName)
+		if err != nil {
+			// role mapping was deleted outside of terraform, remove from state so the next plan recreates it
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for role mapping",
+					fmt.Sprintf("Error occurred querying for role mapping %q: %v", roleName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract role mapping from response, treating absence as drift
+		if osMapping, ok = osMappings[roleName]; !ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	// update data object from source role mapping
+	updateDiags = stateData.UpdateFromRoleMapping(roleName, osMapping)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
+
+func (r *PluginSecurityRoleMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		roleName  string
+		osMapping client.PluginSecurityRoleMapping
+		ok        bool
+
+		planData = new(PluginSecurityRoleMappingResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+
+	// extract role name
+	roleName = planData.RoleName.ValueString()
+
+	// attempt to locate role mapping in cluster
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchRoleMappings(ctx, r.client, roleName)
+		if err != nil {
+			if client.IsNotFound(err) {
+				// if the role mapping was not found, prevent the update call from creating a new one.
+				resp.Diagnostics.AddError(
+					"Role mapping not found",
+					fmt.Sprintf("Role mapping %q was not found in cluster", roleName),
+				)
+			} else if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for role mapping",
+					fmt.Sprintf("Error occurred querying for role mapping %q: %v", roleName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute update call
+	{
+		// init request type
+		osReq := &client.PluginSecurityRoleMappingUpsertRequest{
+			Name: roleName,
+		}
+
+		// convert plan data to opensearch model
+		osMapping = terraformSecurityRoleMappingToSecurityRoleMapping(planData)
+
+		jsonB, err := json.Marshal(osMapping)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating role mapping",
+				fmt.Sprintf("Error executing update role mapping request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		updateResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &updateResp, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing update role mapping response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if updateResp.HasErrors() {
+			updateResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+
+	// attempt to fetch updated role mapping
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osMappings, err := tryFetchRoleMappings(ctx, r.client, roleName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching updated role mapping",
+				fmt.Sprintf("Error fetching updated role mapping %q: %v", roleName, err.Error()),
+			)
+			return
+		}
+		if osMapping, ok = osMappings[roleName]; !ok {
+			resp.Diagnostics.AddError(
+				"Role mapping not found",
+				fmt.Sprintf("Unable to locate updated role mapping %q", roleName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromRoleMapping(roleName, osMapping)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityRoleMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		roleName string
+
+		stateData = new(PluginSecurityRoleMappingResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+
+	// extract role name
+	roleName = stateData.RoleName.ValueString()
+
+	// execute delete call
+	{
+		osReq := &client.PluginSecurityRoleMappingDeleteRequest{
+			Name: roleName,
+		}
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error deleting role mapping",
+					fmt.Sprintf("Error occurred deleting role mapping %q: %v", roleName, err),
+				)
+			}
+			return
+		}
+
+		// attempt to parse response
+		sink := client.APIStatusResponse{}
+		if err = client.ParseResponse(osResp, &sink, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing delete role mapping response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		if sink.HasErrors() {
+			sink.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+}
+
+func (r *PluginSecurityRoleMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		roleName    string
+		osMapping   client.PluginSecurityRoleMapping
+		updateDiags diag.Diagnostics
+		ok          bool
+
+		stateData = new(PluginSecurityRoleMappingResourceData)
+	)
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	ctx = r.operationContext(ctx, operationImport)
+
+	// extract role name
+	roleName = req.ID
+
+	// query for role mapping from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osMappings, err := tryFetchRoleMappings(ctx, r.client, roleName)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for role mapping",
+					fmt.Sprintf("Error occurred querying for role mapping %q: %v", roleName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract role mapping from response
+		if osMapping, ok = osMappings[roleName]; !ok {
+			resp.Diagnostics.AddError(
+				"Role mapping not found",
+				fmt.Sprintf("Role mapping %q not found", roleName),
+			)
+			return
+		}
+	}
+
+	// update data object from source role mapping
+	updateDiags = stateData.UpdateFromRoleMapping(roleName, osMapping)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
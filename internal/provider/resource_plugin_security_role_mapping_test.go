@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityRoleMapping(t *testing.T) {
+	const (
+		resourceName = "test_role_mapping"
+		renamed      = resourceName + "_renamed"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginRoleMapping, resourceName)
+	)
+
+	t.Run("empty-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRoleMappingConfigWith(resourceName, nil),
+					),
+					ExpectError: regexp.MustCompile("required"),
+				},
+			},
+		})
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		const (
+			backendRole1 = "backend_role_1"
+			user1        = "user_1"
+			host1        = "host_1"
+		)
+
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRoleValidConfigWith(resourceName, nil),
+						acctest.PluginSecurityRoleMappingConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrRoleName: acctest.ConfigLiteral(
+								fmt.Sprintf("%s.%s", fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginRole, resourceName), fields.ResourceAttrRoleName),
+							),
+							fields.ResourceAttrBackendRoles: []string{backendRole1},
+							fields.ResourceAttrUsers:        []string{user1},
+							fields.ResourceAttrHosts:        []string{host1},
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrRoleName, resourceName),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0", fields.ResourceAttrBackendRoles),
+							backendRole1,
+						),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0", fields.ResourceAttrUsers),
+							user1,
+						),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0", fields.ResourceAttrHosts),
+							host1,
+						),
+					),
+				},
+				{
+					ResourceName:      resourceFQN,
+					ImportState:       true,
+					ImportStateId:     resourceName,
+					ImportStateVerify: true,
+				},
+				// renaming replaces the role mapping, as the old name cannot be updated in place
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRoleValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrRoleName: renamed,
+						}),
+						acctest.PluginSecurityRoleMappingConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrRoleName: acctest.ConfigLiteral(
+								fmt.Sprintf("%s.%s", fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginRole, resourceName), fields.ResourceAttrRoleName),
+							),
+							fields.ResourceAttrBackendRoles: []string{backendRole1},
+						}),
+					),
+					Check: resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrRoleName, renamed),
+				},
+			},
+		})
+	})
+}
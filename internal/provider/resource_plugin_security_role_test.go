@@ -1,19 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
 	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opensearch-project/opensearch-go"
 )
 
 func TestAcc_PluginSecurityRole(t *testing.T) {
 	const (
 		resourceName = "test_role"
+		renamed      = resourceName + "_renamed"
 	)
 
 	var (
@@ -54,6 +65,105 @@ func TestAcc_PluginSecurityRole(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrRoleName, resourceName),
 					),
 				},
+				// renaming replaces the role, as the old name cannot be updated in place
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityRoleValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrRoleName: renamed,
+						}),
+					),
+					Check: resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrRoleName, renamed),
+				},
+			},
+		})
+	})
+
+	t.Run("invalid-timeout-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.AppendBlock(
+							acctest.PluginSecurityRoleValidConfigWith(resourceName, nil),
+							fields.ResourceAttrTimeouts,
+							map[string]interface{}{
+								fields.ResourceAttrCreate: "soon",
+							},
+						),
+					),
+					ExpectError: regexp.MustCompile("duration"),
+				},
+			},
+		})
+	})
+
+	t.Run("timeouts", func(t *testing.T) {
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(map[string]interface{}{
+							fields.ConfigAttrDefaultTimeout: "30s",
+						}),
+						acctest.AppendBlock(
+							acctest.PluginSecurityRoleValidConfigWith(resourceName, nil),
+							fields.ResourceAttrTimeouts,
+							map[string]interface{}{
+								fields.ResourceAttrCreate: "1m",
+								fields.ResourceAttrRead:   "20s",
+							},
+						),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrRoleName, resourceName),
+						resource.TestCheckResourceAttr(resourceFQN, fmt.Sprintf("%s.%s", fields.ResourceAttrTimeouts, fields.ResourceAttrCreate), "1m"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("deleted-outside-terraform", func(t *testing.T) {
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		config := acctest.CombineConfig(
+			acctest.ProviderConfigLocalhostWith(),
+			acctest.PluginSecurityRoleValidConfigWith(resourceName, nil),
+		)
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+				},
+				{
+					PreConfig: func() {
+						osReq := client.PluginSecurityRoleDeleteRequest{Name: resourceName}
+						osResp, err := osReq.Do(context.Background(), testAccClient(t))
+						if err != nil {
+							t.Fatalf("Error deleting role %q: %v", resourceName, err)
+						}
+						client.HandleResponseCleanup(osResp)
+					},
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrRoleName, resourceName),
+					),
+				},
 			},
 		})
 	})
@@ -134,3 +244,89 @@ func TestAcc_PluginSecurityRole(t *testing.T) {
 		})
 	})
 }
+
+func TestUnit_PluginSecurityRoleExistenceCheck(t *testing.T) {
+	const roleName = "test_role"
+
+	ctx := context.Background()
+
+	newResource := func(t *testing.T, status int) (*PluginSecurityRoleResource, *int) {
+		t.Helper()
+		var writes int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method != http.MethodGet {
+				writes++
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"status":"OK"}`))
+				return
+			}
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				_, _ = w.Write([]byte(fmt.Sprintf(`{%q:{"cluster_permissions":[]}}`, roleName)))
+			} else {
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"status":%q,"message":"test"}`, http.StatusText(status))))
+			}
+		}))
+		t.Cleanup(srv.Close)
+
+		osClient, err := opensearch.NewClient(opensearch.Config{Addresses: []string{srv.URL}, UseResponseCheckOnly: true, DisableRetry: true})
+		if err != nil {
+			t.Fatalf("error constructing client: %v", err)
+		}
+		r := NewPluginSecurityRoleResource().(*PluginSecurityRoleResource)
+		r.Configure(ctx, tfresource.ConfigureRequest{ProviderData: &Shared{Client: osClient, DefaultTimeout: time.Second}}, new(tfresource.ConfigureResponse))
+		return r, &writes
+	}
+
+	schemaResp := new(tfresource.SchemaResponse)
+	NewPluginSecurityRoleResource().Schema(ctx, tfresource.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for k, at := range typ.AttributeTypes {
+		attrs[k] = tftypes.NewValue(at, nil)
+	}
+	attrs[fields.ResourceAttrRoleName] = tftypes.NewValue(tftypes.String, roleName)
+	raw := tftypes.NewValue(typ, attrs)
+
+	for name, tc := range map[string]struct {
+		status int
+		title  string
+	}{
+		"exists":    {status: http.StatusOK, title: "Role already exists"},
+		"forbidden": {status: http.StatusForbidden, title: "Forbidden"},
+		"error":     {status: http.StatusInternalServerError, title: "Internal Server Error"},
+	} {
+		r, writes := newResource(t, tc.status)
+		resp := &tfresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+		r.Create(ctx, tfresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("create %s: expected error", name)
+		} else if s := resp.Diagnostics.Errors()[0].Summary() + resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(s, tc.title) {
+			t.Errorf("create %s: expected error referencing %q, saw %v", name, tc.title, resp.Diagnostics)
+		}
+		if *writes != 0 {
+			t.Errorf("create %s: expected no write requests, saw %d", name, *writes)
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		status int
+		title  string
+	}{
+		"not-found": {status: http.StatusNotFound, title: "Role not found"},
+		"error":     {status: http.StatusInternalServerError, title: "Internal Server Error"},
+	} {
+		r, writes := newResource(t, tc.status)
+		resp := &tfresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}
+		r.Update(ctx, tfresource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}, State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("update %s: expected error", name)
+		} else if s := resp.Diagnostics.Errors()[0].Summary() + resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(s, tc.title) {
+			t.Errorf("update %s: expected error referencing %q, saw %v", name, tc.title, resp.Diagnostics)
+		}
+		if *writes != 0 {
+			t.Errorf("update %s: expected no write requests, saw %d", name, *writes)
+		}
+	}
+}
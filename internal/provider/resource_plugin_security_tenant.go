@@ -0,0 +1,586 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewPluginSecurityTenantResource() resource.Resource {
+	r := new(PluginSecurityTenantResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginTenant)
+	r.minimumVersion = minimumPluginSecurityVersion
+	return r
+}
+
+type PluginSecurityTenantResource struct {
+	ResourceShared
+}
+
+type PluginSecurityTenantResourceData struct {
+	ID types.String `tfsdk:"id"`
+
+	TenantName  types.String `tfsdk:"tenant_name"`
+	Description types.String `tfsdk:"description"`
+
+	Reserved types.Bool `tfsdk:"reserved"`
+	Hidden   types.Bool `tfsdk:"hidden"`
+	Static   types.Bool `tfsdk:"static"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (d *PluginSecurityTenantResourceData) UpdateFromTenant(tenantName string, t client.PluginSecurityTenant) diag.Diagnostics {
+	d.TenantName = types.StringValue(tenantName)
+
+	// set id to tenant name so framework is happy
+	d.ID = d.TenantName
+
+	d.Description = types.StringValue(t.Description)
+
+	// set "computed" values
+	d.Hidden = conv.BoolPtrToBoolValue(t.Hidden)
+	d.Static = conv.BoolPtrToBoolValue(t.Static)
+	d.Reserved = conv.BoolPtrToBoolValue(t.Reserved)
+
+	return diag.Diagnostics{}
+}
+
+func (r *PluginSecurityTenantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fields.TypeName(req.ProviderTypeName, fields.ResourceTypeSecurityPluginTenant)
+}
+
+func (r *PluginSecurityTenantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenSearch Security Plugin Tenant",
+		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
+			fields.ResourceAttrTenantName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validation.Required(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			fields.ResourceAttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					defaultValuedStringPlanModifier(""),
+				},
+			},
+			fields.ResourceAttrStatic: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrHidden: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrReserved: schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *PluginSecurityTenantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		tenantName string
+		osTenant   client.PluginSecurityTenant
+		ok         bool
+
+		planData = new(PluginSecurityTenantResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+
+	// extract tenant name
+	tenantName = planData.TenantName.ValueString()
+
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchTenants(ctx, r.client, tenantName)
+		if err == nil {
+			resp.Diagnostics.AddError(
+				"Tenant already exists",
+				fmt.Sprintf("Tenant %q already exists in cluster", tenantName),
+			)
+			return
+		}
+		// anything other than not found means existence could not be determined, so the create is not attempted
+		if !client.IsNotFound(err) {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for tenant",
+					fmt.Sprintf("Error occurred looking for existing tenant %q: %v", tenantName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute create request
+	{
+		// init request type
+		osReq := &client.PluginSecurityTenantUpsertRequest{
+			Name: tenantName,
+		}
+
+		// convert plan data to opensearch model
+		osTenant = terraformSecurityTenantToSecurityTenant(planData)
+
+		jsonB, err := json.Marshal(osTenant)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		// execute create call
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating tenant",
+				fmt.Sprintf("Error executing create tenant request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		createResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &createResp, http.StatusCreated); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing create tenant response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if createResp.HasErrors() {
+			createResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+
+		// check for warnings
+		if len(createResp.WarningsHeader) > 0 {
+			for _, w := range createResp.WarningsHeader {
+				resp.Diagnostics.AddWarning(
+					w,
+					fmt.Sprintf("Warning received after creating tenant %q: %v", tenantName, w),
+				)
+			}
+		}
+	}
+
+	// attempt to fetch newly created tenant
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osTenants, err := tryFetchTenants(ctx, r.client, tenantName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching newly created tenant",
+				fmt.Sprintf("Error fetching newly created tenant %q: %v", tenantName, err.Error()),
+			)
+			return
+		}
+		if osTenant, ok = osTenants[tenantName]; !ok {
+			resp.Diagnostics.AddError(
+				"Tenant not found",
+				fmt.Sprintf("Unable to locate newly created tenant %q", tenantName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromTenant(tenantName, osTenant)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityTenantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		tenantName  string
+		osTenant    client.PluginSecurityTenant
+		updateDiags diag.Diagnostics
+		ok          bool
+
+		stateData = new(PluginSecurityTenantResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+
+	// extract tenant name
+	tenantName = stateData.TenantName.ValueString()
+
+	// query for tenant from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osTenants, err := tryFetchTenants(ctx, r.client, tenantName)
+		if err != nil {
+			// tenant was deleted outside of terraform, remove from state so the next plan recreates it
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for tenant",
+					fmt.Sprintf("Error occurred querying for tenant %q: %v", tenantName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract tenant from response, treating absence as drift
+		if osTenant, ok = osTenants[tenantName]; !ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	// update data object from source tenant
+	updateDiags = stateData.UpdateFromTenant(tenantName, osTenant)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
+
+func (r *PluginSecurityTenantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		tenantName string
+		osTenant   client.PluginSecurityTenant
+		ok         bool
+
+		planData = new(PluginSecurityTenantResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+
+	// extract tenant name
+	tenantName = planData.TenantName.ValueString()
+
+	// attempt to locate tenant in cluster
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchTenants(ctx, r.client, tenantName)
+		if err != nil {
+			if client.IsNotFound(err) {
+				// if the tenant was not found, prevent the update call from creating a new one.
+				resp.Diagnostics.AddError(
+					"Tenant not found",
+					fmt.Sprintf("Tenant %q was not found in cluster", tenantName),
+				)
+			} else if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for tenant",
+					fmt.Sprintf("Error occurred querying for tenant %q: %v", tenantName, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute update call
+	{
+		// init request type
+		osReq := &client.PluginSecurityTenantUpsertRequest{
+			Name: tenantName,
+		}
+
+		// convert plan data to opensearch model
+		osTenant = terraformSecurityTenantToSecurityTenant(planData)
+
+		jsonB, err := json.Marshal(osTenant)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating tenant",
+				fmt.Sprintf("Error executing update tenant request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		updateResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &updateResp, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing update tenant response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if updateResp.HasErrors() {
+			updateResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+
+	// attempt to fetch updated tenant
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osTenants, err := tryFetchTenants(ctx, r.client, tenantName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching updated tenant",
+				fmt.Sprintf("Error fetching updated tenant %q: %v", tenantName, err.Error()),
+			)
+			return
+		}
+		if osTenant, ok = osTenants[tenantName]; !ok {
+			resp.Diagnostics.AddError(
+				"Tenant not found",
+				fmt.Sprintf("Unable to locate updated tenant %q", tenantName),
+			)
+			return
+		}
+	}
+
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromTenant(tenantName, osTenant)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
+}
+
+func (r *PluginSecurityTenantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		tenantName string
+
+		stateData = new(PluginSecurityTenantResourceData)
+	)
+
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+
+	// extract tenant name
+	tenantName = stateData.TenantName.ValueString()
+
+	// execute delete call
+	{
+		osReq := &client.PluginSecurityTenantDeleteRequest{
+			Name: tenantName,
+		}
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error deleting tenant",
+					fmt.Sprintf("Error occurred deleting tenant %q: %v", tenantName, err),
+				)
+			}
+			return
+		}
+
+		// attempt to parse response
+		sink := client.APIStatusResponse{}
+		if err = client.ParseResponse(osResp, &sink, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing delete tenant response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		if sink.HasErrors() {
+			sink.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
+}
+
+func (r *PluginSecurityTenantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		tenantName  string
+		osTenant    client.PluginSecurityTenant
+		updateDiags diag.Diagnostics
+		ok          bool
+
+		stateData = new(PluginSecurityTenantResourceData)
+	)
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	ctx = r.operationContext(ctx, operationImport)
+
+	// extract tenant name
+	tenantName = req.ID
+
+	// query for tenant from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osTenants, err := tryFetchTenants(ctx, r.client, tenantName)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for tenant",
+					fmt.Sprintf("Error occurred querying for tenant %q: %v", tenantName, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract tenant from response
+		if osTenant, ok = osTenants[tenantName]; !ok {
+			resp.Diagnostics.AddError(
+				"Tenant not found",
+				fmt.Sprintf("Tenant %q not found", tenantName),
+			)
+			return
+		}
+	}
+
+	// update data object from source tenant
+	updateDiags = stateData.UpdateFromTenant(tenantName, osTenant)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityTenant(t *testing.T) {
+	const (
+		resourceName = "test_tenant"
+		renamed      = resourceName + "_renamed"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginTenant, resourceName)
+	)
+
+	t.Run("empty-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityTenantConfigWith(resourceName, nil),
+					),
+					ExpectError: regexp.MustCompile("required"),
+				},
+			},
+		})
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		const (
+			description = "test tenant"
+		)
+
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityTenantValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrDescription: description,
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrTenantName, resourceName),
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrDescription, description),
+					),
+				},
+				{
+					ResourceName:      resourceFQN,
+					ImportState:       true,
+					ImportStateId:     resourceName,
+					ImportStateVerify: true,
+				},
+				// renaming replaces the tenant, as the old name cannot be updated in place
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityTenantValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrTenantName:  renamed,
+							fields.ResourceAttrDescription: description,
+						}),
+					),
+					Check: resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrTenantName, renamed),
+				},
+			},
+		})
+	})
+}
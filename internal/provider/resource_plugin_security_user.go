@@ -1,23 +1,29 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/conv"
 	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
 	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func NewPluginSecurityUserResource() resource.Resource {
 	r := new(PluginSecurityUserResource)
+	r.typeName = fields.TypeName(fields.ProviderName, fields.ResourceTypeSecurityPluginUser)
+	r.minimumVersion = minimumPluginSecurityVersion
 	return r
 }
 
@@ -26,19 +32,41 @@ type PluginSecurityUserResource struct {
 }
 
 type PluginSecurityUserResourceData struct {
+	ID types.String `tfsdk:"id"`
+
 	Username   types.String `tfsdk:"username"`
 	Password   types.String `tfsdk:"password"`
 	Hash       types.String `tfsdk:"hash"`
 	Attributes types.Map    `tfsdk:"attributes"`
 
-	// used on read
+	OpenDistroSecurityRoles types.List `tfsdk:"opendistro_security_roles"`
+	BackendRoles            types.List `tfsdk:"backend_roles"`
+
+	Reserved types.Bool `tfsdk:"reserved"`
+	Hidden   types.Bool `tfsdk:"hidden"`
+	Static   types.Bool `tfsdk:"static"`
 
-	Roles types.List `tfsdk:"roles"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
 
-	// needed on create and update
+// UpdateFromUser updates the data model with the values returned by the cluster.  The password and hash values are
+// never returned by the API, so whatever is currently in the model is retained.
+func (d *PluginSecurityUserResourceData) UpdateFromUser(username string, u client.PluginSecurityUser) diag.Diagnostics {
+	d.Username = types.StringValue(username)
 
-	OpenDistroSecurityRoles types.List `tfsdk:"open_distro_security_roles"`
-	BackendRoles            types.List `tfsdk:"backend_roles"`
+	// set id to username so framework is happy
+	d.ID = d.Username
+
+	d.BackendRoles = conv.StringsToStringList(u.BackendRoles, true)
+	d.OpenDistroSecurityRoles = conv.StringsToStringList(u.OpenDistroSecurityRoles, true)
+	d.Attributes = stringsToTerraformStringMap(u.Attributes, true)
+
+	// set "computed" values
+	d.Hidden = conv.BoolPtrToBoolValue(u.Hidden)
+	d.Static = conv.BoolPtrToBoolValue(u.Static)
+	d.Reserved = conv.BoolPtrToBoolValue(u.Reserved)
+
+	return diag.Diagnostics{}
 }
 
 func (r *PluginSecurityUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,144 +75,556 @@ func (r *PluginSecurityUserResource) Metadata(_ context.Context, req resource.Me
 
 func (r *PluginSecurityUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		// This description is used by the documentation generator and the language server.
 		Description: "OpenSearch Security Plugin User",
-
 		Attributes: map[string]schema.Attribute{
+			fields.ResourceAttrID: schema.StringAttribute{
+				Computed: true,
+			},
+
 			fields.ResourceAttrUsername: schema.StringAttribute{
 				Description: "Username",
 				Required:    true,
 				Validators: []validator.String{
 					validation.Required(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			fields.ResourceAttrPassword: schema.StringAttribute{
-				Description: "User password",
-				Required:    true,
+				Description: "User password.  Mutually exclusive with hash.",
+				Optional:    true,
 				Sensitive:   true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				Validators: []validator.String{
+					validation.MutuallyExclusiveSibling(fields.ResourceAttrHash),
 				},
 			},
 			fields.ResourceAttrHash: schema.StringAttribute{
-				Computed: true,
-			},
-			fields.ResourceAttrRoles: schema.ListAttribute{
-				Computed:    true,
-				ElementType: types.StringType,
+				Description: "BCrypt hash of the user password.  Mutually exclusive with password.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					validation.MutuallyExclusiveSibling(fields.ResourceAttrPassword),
+				},
 			},
 			fields.ResourceAttrAttributes: schema.MapAttribute{
-				Optional: true,
+				Description: "Arbitrary user attributes",
+				Optional:    true,
+				ElementType: types.StringType,
 			},
 			fields.ResourceAttrBackendRoles: schema.ListAttribute{
-				Optional: true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.UseStateForUnknown(),
-				},
+				Description: "Backend roles assigned to this user",
+				Optional:    true,
+				ElementType: types.StringType,
 			},
 			fields.ResourceAttrOpenDistroSecurityRoles: schema.ListAttribute{
-				Optional: true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.UseStateForUnknown(),
-				},
+				Description: "Security roles assigned directly to this user",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			fields.ResourceAttrStatic: schema.BoolAttribute{
+				Computed: true,
+			},
+			fields.ResourceAttrHidden: schema.BoolAttribute{
+				Computed: true,
 			},
+			fields.ResourceAttrReserved: schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			fields.ResourceAttrTimeouts: resourceTimeoutsBlock(),
 		},
 	}
 }
 
 func (r *PluginSecurityUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *PluginSecurityUserResourceData
+	var (
+		username string
+		osUser   client.PluginSecurityUser
+		ok       bool
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		planData = new(PluginSecurityUserResourceData)
+	)
+
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrCreate, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrCreate)
+
+	// extract username
+	username = planData.Username.ValueString()
+
+	// a new user cannot be created without some form of credential
+	if planData.Password.ValueString() == "" && planData.Hash.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing user credential",
+			fmt.Sprintf("One of %q or %q must be provided when creating user %q", fields.ResourceAttrPassword, fields.ResourceAttrHash, username),
+		)
+		return
+	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create example, got error: %s", err))
-	//     return
-	// }
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchUsers(ctx, r.client, username)
+		if err == nil {
+			resp.Diagnostics.AddError(
+				"User already exists",
+				fmt.Sprintf("User %q already exists in cluster", username),
+			)
+			return
+		}
+		// anything other than not found means existence could not be determined, so the create is not attempted
+		if !client.IsNotFound(err) {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for user",
+					fmt.Sprintf("Error occurred looking for existing user %q: %v", username, err.Error()),
+				)
+			}
+			return
+		}
+	}
 
-	// For the purposes of this example code, hardcoding a response value to
-	// save into the Terraform state.
-	//data.Id = types.StringValue("example-id")
+	// execute create request
+	{
+		// init request type
+		osReq := &client.PluginSecurityUserUpsertRequest{
+			Name: username,
+		}
+
+		// convert plan data to opensearch model
+		osUser = terraformSecurityUserToSecurityUser(planData)
+
+		jsonB, err := json.Marshal(osUser)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		// execute create call
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating user",
+				fmt.Sprintf("Error executing create user request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		createResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &createResp, http.StatusCreated); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing create user response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if createResp.HasErrors() {
+			createResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+
+		// check for warnings
+		if len(createResp.WarningsHeader) > 0 {
+			for _, w := range createResp.WarningsHeader {
+				resp.Diagnostics.AddWarning(
+					w,
+					fmt.Sprintf("Warning received after creating user %q: %v", username, w),
+				)
+			}
+		}
+	}
 
-	// Write logs using the tflog package
-	// Documentation: https://terraform.io/plugin/log
-	tflog.Trace(ctx, "created a resource")
+	// attempt to fetch newly created user
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osUsers, err := tryFetchUsers(ctx, r.client, username)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching newly created user",
+				fmt.Sprintf("Error fetching newly created user %q: %v", username, err.Error()),
+			)
+			return
+		}
+		if osUser, ok = osUsers[username]; !ok {
+			resp.Diagnostics.AddError(
+				"User not found",
+				fmt.Sprintf("Unable to locate newly created user %q", username),
+			)
+			return
+		}
+	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromUser(username, osUser)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
 }
 
 func (r *PluginSecurityUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data *PluginSecurityUserResourceData
+	var (
+		username    string
+		osUser      client.PluginSecurityUser
+		updateDiags diag.Diagnostics
+		ok          bool
 
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		stateData = new(PluginSecurityUserResourceData)
+	)
 
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrRead)
+
+	// extract username
+	username = stateData.Username.ValueString()
+
+	// query for user from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osUsers, err := tryFetchUsers(ctx, r.client, username)
+		if err != nil {
+			// user was deleted outside of terraform, remove from state so the next plan recreates it
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for user",
+					fmt.Sprintf("Error occurred querying for user %q: %v", username, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract user from response, treating absence as drift
+		if osUser, ok = osUsers[username]; !ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	// update data object from source user
+	updateDiags = stateData.UpdateFromUser(username, osUser)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
 }
 
 func (r *PluginSecurityUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *PluginSecurityUserResourceData
+	var (
+		username string
+		osUser   client.PluginSecurityUser
+		ok       bool
+
+		planData = new(PluginSecurityUserResourceData)
+	)
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// marshal plan value into data type, appending errors to response
+	resp.Diagnostics.Append(req.Plan.Get(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(planData.Timeouts, fields.ResourceAttrUpdate, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrUpdate)
+
+	// extract username
+	username = planData.Username.ValueString()
+
+	// attempt to locate user in cluster
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, _, err := tryFetchUsers(ctx, r.client, username)
+		if err != nil {
+			if client.IsNotFound(err) {
+				// if the user was not found, prevent the update call from creating a new one.
+				resp.Diagnostics.AddError(
+					"User not found",
+					fmt.Sprintf("User %q was not found in cluster", username),
+				)
+			} else if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for user",
+					fmt.Sprintf("Error occurred querying for user %q: %v", username, err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	// execute update call
+	{
+		// init request type
+		osReq := &client.PluginSecurityUserUpsertRequest{
+			Name: username,
+		}
+
+		// convert plan data to opensearch model.  if neither password nor hash are provided, the existing
+		// credential is retained by the cluster.
+		osUser = terraformSecurityUserToSecurityUser(planData)
+
+		jsonB, err := json.Marshal(osUser)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling plan into OpenSearch request",
+				fmt.Sprintf("Error json-encoding plan data into OpenSearch request: %v", err),
+			)
+			return
+		}
+
+		// set request body
+		osReq.Body = bytes.NewReader(jsonB)
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating user",
+				fmt.Sprintf("Error executing update user request: %v", err),
+			)
+			return
+		}
+
+		// create response container
+		updateResp := client.APIStatusResponse{}
+
+		// attempt to parse response
+		if err = client.ParseResponse(osResp, &updateResp, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing update user response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		// check for errors
+		if updateResp.HasErrors() {
+			updateResp.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	// attempt to fetch updated user
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osUsers, err := tryFetchUsers(ctx, r.client, username)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching updated user",
+				fmt.Sprintf("Error fetching updated user %q: %v", username, err.Error()),
+			)
+			return
+		}
+		if osUser, ok = osUsers[username]; !ok {
+			resp.Diagnostics.AddError(
+				"User not found",
+				fmt.Sprintf("Unable to locate updated user %q", username),
+			)
+			return
+		}
+	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// otherwise, try to update state model with new data
+	resp.Diagnostics.Append(planData.UpdateFromUser(username, osUser)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// finally, try to update state itself with updated model
+	resp.Diagnostics.Append(resp.State.Set(ctx, planData)...)
 }
 
 func (r *PluginSecurityUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data *PluginSecurityUserResourceData
+	var (
+		username string
 
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		stateData = new(PluginSecurityUserResourceData)
+	)
 
+	// marshal state value into data type, appending errors to response
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete example, got error: %s", err))
-	//     return
-	// }
+	// determine when this operation must complete by
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrDelete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = r.operationContext(ctx, fields.ResourceAttrDelete)
+
+	// extract username
+	username = stateData.Username.ValueString()
+
+	// execute delete call
+	{
+		osReq := &client.PluginSecurityUserDeleteRequest{
+			Name: username,
+		}
+
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		osResp, err := osReq.Do(ctx, r.client)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error deleting user",
+					fmt.Sprintf("Error occurred deleting user %q: %v", username, err),
+				)
+			}
+			return
+		}
+
+		// attempt to parse response
+		sink := client.APIStatusResponse{}
+		if err = client.ParseResponse(osResp, &sink, http.StatusOK); err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error parsing delete user response",
+					err.Error(),
+				)
+			}
+			return
+		}
+
+		if sink.HasErrors() {
+			sink.AppendDiagnostics(&resp.Diagnostics)
+			return
+		}
+	}
 }
 
 func (r *PluginSecurityUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var (
+		username    string
+		osUser      client.PluginSecurityUser
+		updateDiags diag.Diagnostics
+		ok          bool
+
+		stateData = new(PluginSecurityUserResourceData)
+	)
+
+	// imports have no configuration, so start with no timeouts and use the default
+	stateData.Timeouts = resourceTimeoutsNull()
+	deadline := r.operationDeadline(stateData.Timeouts, fields.ResourceAttrRead, &resp.Diagnostics)
+	ctx = r.operationContext(ctx, operationImport)
+
+	// extract username
+	username = req.ID
+
+	// query for user from cluster
+	// done in sub-context to avoid poisoning ctx var
+	{
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		_, osUsers, err := tryFetchUsers(ctx, r.client, username)
+		if err != nil {
+			if m, ok := err.(*client.APIStatusResponse); ok {
+				m.AppendDiagnostics(&resp.Diagnostics)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error querying for user",
+					fmt.Sprintf("Error occurred querying for user %q: %v", username, err.Error()),
+				)
+			}
+			return
+		}
+
+		// attempt to extract user from response
+		if osUser, ok = osUsers[username]; !ok {
+			resp.Diagnostics.AddError(
+				"User not found",
+				fmt.Sprintf("User %q not found", username),
+			)
+			return
+		}
+	}
+
+	// update data object from source user
+	updateDiags = stateData.UpdateFromUser(username, osUser)
+
+	// append any / all diagnostics to response diags
+	resp.Diagnostics.Append(updateDiags...)
+
+	// if there were errors, end now
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state from remote, appending any resulting diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateData)...)
 }
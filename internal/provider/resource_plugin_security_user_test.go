@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/dcarbone/terraform-provider-opensearch/internal/acctest"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PluginSecurityUser(t *testing.T) {
+	const (
+		resourceName = "test_user"
+		renamed      = resourceName + "_renamed"
+	)
+
+	var (
+		resourceFQN = fields.ResourceTypeFQN(fields.ProviderName, fields.ResourceTypeSecurityPluginUser, resourceName)
+	)
+
+	t.Run("empty-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityUserConfigWith(resourceName, nil),
+					),
+					ExpectError: regexp.MustCompile("required"),
+				},
+			},
+		})
+	})
+
+	t.Run("password-and-hash-throws-error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityUserValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrHash: "$2y$12$88IFVl6IfIwCFh5aQYfOmuXVL9j2hz/GusQb35o.4sdTDAEMTOD.K",
+						}),
+					),
+					ExpectError: regexp.MustCompile("Mutually exclusive"),
+				},
+			},
+		})
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		const (
+			backendRole1 = "backend_role_1"
+			backendRole2 = "backend_role_2"
+		)
+
+		if os.Getenv("OPENSEARCH_USERNAME") == "" {
+			t.Setenv("OPENSEARCH_USERNAME", "admin")
+		}
+		if os.Getenv("OPENSEARCH_PASSWORD") == "" {
+			t.Setenv("OPENSEARCH_PASSWORD", "admin")
+		}
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityUserValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrBackendRoles: []string{
+								backendRole1,
+								backendRole2,
+							},
+							fields.ResourceAttrAttributes: map[string]string{
+								"team": "ops",
+							},
+						}),
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrUsername, resourceName),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.0", fields.ResourceAttrBackendRoles),
+							backendRole1,
+						),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.1", fields.ResourceAttrBackendRoles),
+							backendRole2,
+						),
+						resource.TestCheckResourceAttr(
+							resourceFQN,
+							fmt.Sprintf("%s.team", fields.ResourceAttrAttributes),
+							"ops",
+						),
+					),
+				},
+				{
+					ResourceName:            resourceFQN,
+					ImportState:             true,
+					ImportStateId:           resourceName,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{fields.ResourceAttrPassword},
+				},
+				// renaming replaces the user, as the old name cannot be updated in place
+				{
+					Config: acctest.CombineConfig(
+						acctest.ProviderConfigLocalhostWith(),
+						acctest.PluginSecurityUserValidConfigWith(resourceName, map[string]interface{}{
+							fields.ResourceAttrUsername: renamed,
+						}),
+					),
+					Check: resource.TestCheckResourceAttr(resourceFQN, fields.ResourceAttrUsername, renamed),
+				},
+			},
+		})
+	})
+}
@@ -3,18 +3,49 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/dcarbone/terraform-provider-opensearch/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/opensearch-project/opensearch-go"
 )
 
+const (
+	// defaultOpaqueIDPrefix begins the X-Opaque-Id header when no prefix is configured
+	defaultOpaqueIDPrefix = "terraform"
+
+	// operationImport identifies requests made while importing a resource
+	operationImport = "import"
+)
+
 type Shared struct {
-	Client *opensearch.Client
+	Client         *opensearch.Client
+	DefaultTimeout time.Duration
+
+	// OpaqueIDPrefix is the configured prefix and provider version that begin the X-Opaque-Id header sent with each
+	// request, and are followed by the type name and operation
+	OpaqueIDPrefix string
+
+	// ClusterDistribution and ClusterVersion are reported by the cluster during the init compatibility check.  When
+	// the check is skipped, the distribution is empty and the version is the configured minimum version, if any.
+	ClusterDistribution string
+	ClusterVersion      client.Version
 }
 
 type ResourceShared struct {
 	providerTypeName string
+	typeName         string
 	client           *opensearch.Client
+	defaultTimeout   time.Duration
+	opaqueIDPrefix   string
+
+	// minimumVersion is the oldest OpenSearch version supported by the type
+	minimumVersion client.Version
+	clusterVersion client.Version
 }
 
 func (s *ResourceShared) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -40,4 +71,95 @@ func (s *ResourceShared) Configure(_ context.Context, req resource.ConfigureRequ
 
 	// embed client
 	s.client = shd.Client
+	s.defaultTimeout = shd.DefaultTimeout
+	s.opaqueIDPrefix = shd.OpaqueIDPrefix
+	s.clusterVersion = shd.ClusterVersion
+}
+
+type DataSourceShared struct {
+	providerTypeName string
+	typeName         string
+	client           *opensearch.Client
+	defaultTimeout   time.Duration
+	opaqueIDPrefix   string
+
+	// minimumVersion is the oldest OpenSearch version supported by the type
+	minimumVersion client.Version
+	clusterVersion client.Version
+}
+
+func (s *DataSourceShared) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		resp.Diagnostics.AddWarning("Provider is not configured", "Provider is not configured")
+		return
+	}
+
+	// ensure we got what we expected
+	shd, ok := req.ProviderData.(*Shared)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.",
+				new(Shared),
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	// embed client
+	s.client = shd.Client
+	s.defaultTimeout = shd.DefaultTimeout
+	s.opaqueIDPrefix = shd.OpaqueIDPrefix
+	s.clusterVersion = shd.ClusterVersion
+}
+
+// operationDeadline returns the deadline for the named operation, using the value from the resource's timeouts block
+// if set or the provider default otherwise
+func (s *ResourceShared) operationDeadline(timeouts types.Object, op string, diags *diag.Diagnostics) time.Time {
+	return time.Now().Add(operationTimeout(timeouts, op, s.defaultTimeout, diags))
+}
+
+// operationDeadline returns the deadline for the named operation, using the value from the data source's timeouts
+// block if set or the provider default otherwise
+func (s *DataSourceShared) operationDeadline(timeouts types.Object, op string, diags *diag.Diagnostics) time.Time {
+	return time.Now().Add(operationTimeout(timeouts, op, s.defaultTimeout, diags))
+}
+
+// ModifyPlan fails the plan if the cluster is older than the resource type supports.  Destroy plans are not checked.
+func (s *ResourceShared) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	checkMinimumVersion(s.typeName, s.minimumVersion, s.clusterVersion, &resp.Diagnostics)
+}
+
+// checkMinimumVersion adds an error if the cluster is older than the data source type supports
+func (s *DataSourceShared) checkMinimumVersion(diags *diag.Diagnostics) {
+	checkMinimumVersion(s.typeName, s.minimumVersion, s.clusterVersion, diags)
+}
+
+// operationContext returns a context whose requests identify the resource type and operation that sent them with the
+// X-Opaque-Id header
+func (s *ResourceShared) operationContext(ctx context.Context, op string) context.Context {
+	return client.WithOpaqueID(ctx, buildOpaqueID(s.opaqueIDPrefix, s.typeName, op))
+}
+
+// operationContext returns a context whose requests identify the data source type and operation that sent them with
+// the X-Opaque-Id header
+func (s *DataSourceShared) operationContext(ctx context.Context, op string) context.Context {
+	return client.WithOpaqueID(ctx, buildOpaqueID(s.opaqueIDPrefix, s.typeName, op))
+}
+
+// buildOpaqueID joins the non-empty parts of an X-Opaque-Id value with "/"
+func buildOpaqueID(parts ...string) string {
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, "/")
 }
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dcarbone/terraform-plugin-framework-utils/v3/validation"
+	"github.com/dcarbone/terraform-provider-opensearch/internal/fields"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOperationTimeout is used when neither the provider nor the resource specify a timeout
+const defaultOperationTimeout = 10 * time.Second
+
+var resourceTimeoutsAttrTypeMap = map[string]attr.Type{
+	fields.ResourceAttrCreate: types.StringType,
+	fields.ResourceAttrRead:   types.StringType,
+	fields.ResourceAttrUpdate: types.StringType,
+	fields.ResourceAttrDelete: types.StringType,
+}
+
+func timeoutAttributeDescription(op string) string {
+	return fmt.Sprintf("Maximum duration of the %s operation, e.g. \"30s\" or \"2m\".  Defaults to the provider's %s.", op, fields.ConfigAttrDefaultTimeout)
+}
+
+// resourceTimeoutsBlock returns the timeouts block added to every resource schema
+func resourceTimeoutsBlock() schema.SingleNestedBlock {
+	attrs := make(map[string]schema.Attribute, len(resourceTimeoutsAttrTypeMap))
+	for op := range resourceTimeoutsAttrTypeMap {
+		attrs[op] = schema.StringAttribute{
+			Description: timeoutAttributeDescription(op),
+			Optional:    true,
+			Validators: []validator.String{
+				validation.IsDurationString(),
+			},
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: "Per-operation timeouts",
+		Attributes:  attrs,
+	}
+}
+
+// dataSourceTimeoutsBlock returns the timeouts block added to every data source schema
+func dataSourceTimeoutsBlock() dsschema.SingleNestedBlock {
+	return dsschema.SingleNestedBlock{
+		Description: "Per-operation timeouts",
+		Attributes: map[string]dsschema.Attribute{
+			fields.ResourceAttrRead: dsschema.StringAttribute{
+				Description: timeoutAttributeDescription(fields.ResourceAttrRead),
+				Optional:    true,
+				Validators: []validator.String{
+					validation.IsDurationString(),
+				},
+			},
+		},
+	}
+}
+
+// resourceTimeoutsNull returns an empty timeouts value, for use when constructing state without a plan or prior state
+func resourceTimeoutsNull() types.Object {
+	return types.ObjectNull(resourceTimeoutsAttrTypeMap)
+}
+
+// operationTimeout returns the timeout for the named operation from the provided timeouts block value, falling back
+// to def when not set
+func operationTimeout(timeouts types.Object, op string, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if def <= 0 {
+		def = defaultOperationTimeout
+	}
+
+	if !attributeValued(timeouts) {
+		return def
+	}
+
+	v, ok := timeouts.Attributes()[op].(types.String)
+	if !ok || !attributeValued(v) {
+		return def
+	}
+
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(
+			path.Root(fields.ResourceAttrTimeouts).AtName(op),
+			"Invalid timeout",
+			fmt.Sprintf("Timeout %q must be a positive duration", v.ValueString()),
+		)
+		return def
+	}
+
+	return d
+}
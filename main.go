@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/dcarbone/terraform-provider-opensearch/internal/provider"
+	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -35,7 +36,14 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.NewOpenSearchProvider(version), opts)
+	p := provider.New(version)
+
+	err := providerserver.Serve(context.Background(), func() tfprovider.Provider { return p }, opts)
+
+	// Serve returns once Terraform shuts the provider down, after which any trace files are flushed to disk
+	if cerr := p.Close(); cerr != nil {
+		log.Print(cerr.Error())
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())